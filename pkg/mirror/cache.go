@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// CacheOption represents [Cache] option.
+type CacheOption func(*Cache)
+
+// WithStrictTags makes [Cache.Reflect] and [Cache.ReflectType] return an
+// error when any struct field has a malformed tag. Struct types reachable
+// through fields, pointers, slices, arrays and maps are checked as well.
+func WithStrictTags() CacheOption {
+	return func(c *Cache) { c.strict = true }
+}
+
+// Cache represents [Metadata] cache. The package level functions [Reflect]
+// and [ReflectType] use the default, not strict, cache.
+type Cache struct {
+	strict bool                       // Report tag errors.
+	typs   map[reflect.Type]*Metadata // Type metadata cache.
+	errs   map[reflect.Type]error     // Strict mode tag errors by type.
+	mx     sync.RWMutex               // Guards typs and errs.
+}
+
+// NewCache returns new instance of [Cache].
+func NewCache(opts ...CacheOption) *Cache {
+	c := &Cache{
+		typs: make(map[reflect.Type]*Metadata),
+		errs: make(map[reflect.Type]error),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Reflect extracts [Metadata] about type of "v". In strict mode, it returns
+// an error wrapping [ErrTagSyntax] when any struct field tag is malformed.
+func (c *Cache) Reflect(v any) (*Metadata, error) {
+	return c.ReflectType(reflect.TypeOf(v))
+}
+
+// ReflectType extracts [Metadata] about the type. In strict mode, it returns
+// an error wrapping [ErrTagSyntax] when any struct field tag is malformed.
+func (c *Cache) ReflectType(typ reflect.Type) (*Metadata, error) {
+	typ = indirect(typ)
+
+	c.mx.RLock()
+	md, found := c.typs[typ]
+	err := c.errs[typ]
+	c.mx.RUnlock()
+	if !found {
+		md = NewTypeMetadata(typ)
+		if c.strict {
+			err = tagErrors(md)
+		}
+		c.mx.Lock()
+		c.typs[typ] = md
+		c.errs[typ] = err
+		c.mx.Unlock()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+// tagErrors returns joined tag errors of the struct and all struct types
+// reachable from it. Errors of the reachable types are prefixed with the type.
+func tagErrors(md *Metadata) error {
+	errs := append([]error(nil), md.Errors()...)
+	walkStructs(md.typ, func(nmd *Metadata) {
+		if nmd.typ == md.typ {
+			return
+		}
+		for _, err := range nmd.Errors() {
+			errs = append(errs, fmt.Errorf("%s: %w", nmd.typ, err))
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// IsStrict returns true if the cache is in strict mode.
+func (c *Cache) IsStrict() bool { return c.strict }
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// malformedTagType returns a struct type with malformed tag. The type is
+// constructed dynamically to not trigger the go vet struct tag check.
+func malformedTagType() reflect.Type {
+	return reflect.StructOf([]reflect.StructField{
+		{Name: "F0", Type: reflect.TypeOf(0), Tag: `json:"f0"`},
+		{Name: "F1", Type: reflect.TypeOf(0), Tag: `json:f1`},
+	})
+}
+
+func Test_NewCache(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// --- When ---
+		have := NewCache()
+
+		// --- Then ---
+		assert.False(t, have.strict)
+		assert.NotNil(t, have.typs)
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		// --- When ---
+		have := NewCache(WithStrictTags())
+
+		// --- Then ---
+		assert.True(t, have.strict)
+	})
+}
+
+func Test_Cache_Reflect(t *testing.T) {
+	t.Run("cached", func(t *testing.T) {
+		// --- Given ---
+		c := NewCache()
+		s := &struct{ F int }{}
+
+		// --- When ---
+		have, err := c.Reflect(s)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, have, c.typs[reflect.TypeOf(s).Elem()])
+		again, _ := c.Reflect(s)
+		assert.Same(t, have, again)
+	})
+
+	t.Run("malformed tag not strict", func(t *testing.T) {
+		// --- Given ---
+		c := NewCache()
+
+		// --- When ---
+		have, err := c.ReflectType(malformedTagType())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.NotNil(t, have)
+		assert.Len(t, 1, have.Errors())
+	})
+
+	t.Run("malformed tag strict", func(t *testing.T) {
+		// --- Given ---
+		c := NewCache(WithStrictTags())
+
+		// --- When ---
+		have, err := c.ReflectType(malformedTagType())
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagSyntax, err)
+		assert.ErrorContain(t, `field F1 at offset 0: "json:f1"`, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("malformed tag in nested struct strict", func(t *testing.T) {
+		// --- Given ---
+		c := NewCache(WithStrictTags())
+		typ := reflect.StructOf([]reflect.StructField{
+			{Name: "In", Type: malformedTagType()},
+		})
+
+		// --- When ---
+		have, err := c.ReflectType(typ)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagSyntax, err)
+		assert.ErrorContain(t, `field F1 at offset 0: "json:f1"`, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("malformed tag in element type strict", func(t *testing.T) {
+		// --- Given ---
+		c := NewCache(WithStrictTags())
+		elem := reflect.PointerTo(malformedTagType())
+		typ := reflect.StructOf([]reflect.StructField{
+			{Name: "In", Type: reflect.MapOf(reflect.TypeOf(""), elem)},
+		})
+
+		// --- When ---
+		have, err := c.ReflectType(typ)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagSyntax, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("malformed tag in nested struct not strict", func(t *testing.T) {
+		// --- Given ---
+		c := NewCache()
+		typ := reflect.StructOf([]reflect.StructField{
+			{Name: "In", Type: malformedTagType()},
+		})
+
+		// --- When ---
+		have, err := c.ReflectType(typ)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.NotNil(t, have)
+	})
+
+	t.Run("malformed tag strict cached", func(t *testing.T) {
+		// --- Given ---
+		c := NewCache(WithStrictTags())
+		_, _ = c.ReflectType(malformedTagType())
+
+		// --- When ---
+		have, err := c.ReflectType(malformedTagType())
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagSyntax, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Cache_ReflectType(t *testing.T) {
+	// --- Given ---
+	c := NewCache(WithStrictTags())
+	typ := reflect.TypeOf(&struct{ F int }{})
+
+	// --- When ---
+	have, err := c.ReflectType(typ)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, typ.Elem(), have.Type())
+}
+
+func Test_Cache_IsStrict(t *testing.T) {
+	assert.False(t, NewCache().IsStrict())
+	assert.True(t, NewCache(WithStrictTags()).IsStrict())
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"encoding"
+	"reflect"
+	"time"
+)
+
+// Types used when classifying types.
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// Bits stored in the [Metadata] interface implementation cache.
+const (
+	implValue uint8 = 1 << iota // The value type implements the interface.
+	implPtr                     // The pointer type implements the interface.
+)
+
+// ScalarPolicy decides which types are treated as scalars by
+// [Metadata.IsScalarLike]. Booleans, numbers and strings are always scalars.
+type ScalarPolicy struct {
+	// Time makes [time.Time] a scalar.
+	Time bool
+
+	// Bytes makes slices of bytes, for example, []byte, a scalar.
+	Bytes bool
+
+	// TextMarshaler makes types implementing [encoding.TextMarshaler],
+	// with value or pointer receivers, scalars.
+	TextMarshaler bool
+
+	// Types is a list of additional scalar types.
+	Types []reflect.Type
+}
+
+// DefaultScalarPolicy treats [time.Time] and []byte as scalars.
+var DefaultScalarPolicy = ScalarPolicy{Time: true, Bytes: true}
+
+// IsNumeric returns true for integer, floating point and complex types.
+func (md *Metadata) IsNumeric() bool {
+	return isNumber(md.kind) ||
+		md.kind == reflect.Complex64 || md.kind == reflect.Complex128
+}
+
+// IsInteger returns true for signed and unsigned integer types.
+func (md *Metadata) IsInteger() bool { return isInteger(md.kind) }
+
+// IsNilable returns true for types which can be nil.
+func (md *Metadata) IsNilable() bool { return isNilableKind(md.kind) }
+
+// IsComparable returns true if values of the type are comparable. Values of
+// comparable interface types may still panic when compared.
+func (md *Metadata) IsComparable() bool { return md.comparable }
+
+// Implements returns true if the type implements the interface. Use
+// [Metadata.ImplementsPtr] to check the pointer to the type, which also has
+// methods with pointer receivers. Results are cached. Panics if "iface" is
+// not an interface type.
+func (md *Metadata) Implements(iface reflect.Type) bool {
+	return md.implements(iface)&implValue != 0
+}
+
+// ImplementsPtr returns true if the pointer to the type implements the
+// interface. Results are cached. Panics if "iface" is not an interface type.
+func (md *Metadata) ImplementsPtr(iface reflect.Type) bool {
+	return md.implements(iface)&implPtr != 0
+}
+
+// implements returns the interface implementation bits for the type.
+func (md *Metadata) implements(iface reflect.Type) uint8 {
+	if bits, ok := md.impls.Load(iface); ok {
+		return bits.(uint8)
+	}
+	var bits uint8
+	if md.typ.Implements(iface) {
+		bits |= implValue
+	}
+	if reflect.PointerTo(md.typ).Implements(iface) {
+		bits |= implPtr
+	}
+	md.impls.Store(iface, bits)
+	return bits
+}
+
+// IsScalarLike returns true if the type is a boolean, a number, a string, or
+// it is a scalar according to the policy.
+func (md *Metadata) IsScalarLike(sp ScalarPolicy) bool {
+	switch {
+	case md.kind == reflect.Bool, md.kind == reflect.String, md.IsNumeric():
+		return true
+	case sp.Time && md.typ == timeType:
+		return true
+	case sp.Bytes && md.kind == reflect.Slice &&
+		md.typ.Elem().Kind() == reflect.Uint8:
+		return true
+	case sp.TextMarshaler && md.implements(textMarshalerType) != 0:
+		return true
+	}
+	for _, typ := range sp.Types {
+		if md.typ == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// isNilableKind returns true for kinds which can be nil.
+func isNilableKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map,
+		reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TClassify is a type used in type classification tests.
+type TClassify struct{ Val string }
+
+func (TClassify) String() string { return "" }
+
+func (*TClassify) MarshalText() ([]byte, error) { return nil, nil }
+
+func Test_Metadata_IsNumeric_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    any
+		want bool
+	}{
+		{"int", 1, true},
+		{"uint8", uint8(1), true},
+		{"float64", 1.0, true},
+		{"complex128", complex(1, 2), true},
+		{"named int", time.Second, true},
+		{"string", "a", false},
+		{"bool", true, false},
+		{"struct", TClassify{}, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			md := NewMetadata(tc.v)
+
+			// --- When ---
+			have := md.IsNumeric()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Metadata_IsInteger_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    any
+		want bool
+	}{
+		{"int", 1, true},
+		{"uintptr", uintptr(1), true},
+		{"float64", 1.0, false},
+		{"complex128", complex(1, 2), false},
+		{"string", "a", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			md := NewMetadata(tc.v)
+
+			// --- When ---
+			have := md.IsInteger()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Metadata_IsNilable_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    any
+		want bool
+	}{
+		{"slice", []int{}, true},
+		{"map", map[int]int{}, true},
+		{"func", func() {}, true},
+		{"chan", make(chan int), true},
+		{"pointer to pointer", ptr(&TClassify{}), true},
+		{"pointer to struct", &TClassify{}, false},
+		{"int", 1, false},
+		{"array", [1]int{}, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			md := NewMetadata(tc.v)
+
+			// --- When ---
+			have := md.IsNilable()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Metadata_IsComparable_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    any
+		want bool
+	}{
+		{"int", 1, true},
+		{"struct", TClassify{}, true},
+		{"array", [1]string{}, true},
+		{"slice", []int{}, false},
+		{"map", map[int]int{}, false},
+		{"func", func() {}, false},
+		{"struct with slice", struct{ F []int }{}, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			md := NewMetadata(tc.v)
+
+			// --- When ---
+			have := md.IsComparable()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Metadata_Implements(t *testing.T) {
+	t.Run("value receiver", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TClassify{})
+		iface := reflect.TypeFor[fmt.Stringer]()
+
+		// --- When ---
+		have := md.Implements(iface)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.True(t, md.ImplementsPtr(iface))
+	})
+
+	t.Run("pointer receiver", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TClassify{})
+		iface := reflect.TypeFor[encoding.TextMarshaler]()
+
+		// --- When ---
+		have := md.Implements(iface)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.True(t, md.ImplementsPtr(iface))
+	})
+
+	t.Run("not implemented", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TClassify{})
+		iface := reflect.TypeFor[json.Marshaler]()
+
+		// --- When ---
+		have := md.Implements(iface)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.False(t, md.ImplementsPtr(iface))
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TClassify{})
+		iface := reflect.TypeFor[encoding.TextMarshaler]()
+
+		// --- When ---
+		md.Implements(iface)
+
+		// --- Then ---
+		bits, ok := md.impls.Load(iface)
+		assert.True(t, ok)
+		assert.Equal(t, implPtr, bits)
+	})
+
+	t.Run("not interface", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TClassify{})
+
+		// --- Then ---
+		assert.Panic(t, func() { md.Implements(reflect.TypeOf(1)) })
+	})
+}
+
+func Test_Metadata_IsScalarLike_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    any
+		sp   ScalarPolicy
+		want bool
+	}{
+		{"bool", true, ScalarPolicy{}, true},
+		{"string", "a", ScalarPolicy{}, true},
+		{"float", 1.0, ScalarPolicy{}, true},
+		{"time default", time.Time{}, DefaultScalarPolicy, true},
+		{"time", time.Time{}, ScalarPolicy{}, false},
+		{"bytes default", []byte{}, DefaultScalarPolicy, true},
+		{"raw json", json.RawMessage{}, DefaultScalarPolicy, true},
+		{"bytes", []byte{}, ScalarPolicy{}, false},
+		{"ints", []int{}, DefaultScalarPolicy, false},
+		{"marshaler", TClassify{}, ScalarPolicy{TextMarshaler: true}, true},
+		{"struct", TClassify{}, DefaultScalarPolicy, false},
+		{
+			"additional type",
+			TClassify{},
+			ScalarPolicy{Types: []reflect.Type{reflect.TypeOf(TClassify{})}},
+			true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			md := NewMetadata(tc.v)
+
+			// --- When ---
+			have := md.IsScalarLike(tc.sp)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// cloneMethods caches the index of the "Clone() T" method for types. The
+// index is -1 for types without the method.
+var cloneMethods sync.Map
+
+// Clone returns a deep copy of "v". Structs, pointers, slices, arrays, maps
+// and values stored in interfaces are copied recursively.
+//
+// The copy preserves pointer aliasing - two pointers to the same value in
+// "v" point to the same copy in the result, which also makes cloning of
+// cyclic structures possible.
+//
+// Nested values of types with the "Clone() T" method, where T is the type
+// itself, are copied by calling it. The method is not used for "v" itself,
+// so it is safe to implement it using this function.
+//
+// Struct fields may opt out of the deep copy with the [MirrorTag] tag:
+//
+//	F1 *T `mirror:"shallow"` // Copied as is.
+//	F2 *T `mirror:"-"`       // Not copied, set to its zero value.
+//
+// Unexported struct fields, functions, channels and unsafe pointers are
+// copied as is. Map keys are never cloned.
+func Clone[T any](v T) T {
+	var dst T
+	src := reflect.ValueOf(&v).Elem()
+	reflect.ValueOf(&dst).Elem().Set(cloneValue(src))
+	return dst
+}
+
+// cloneValue returns a deep copy of the value. See [Clone] for details.
+func cloneValue(val reflect.Value) reflect.Value {
+	cl := &cloner{seen: make(map[cloneKey]reflect.Value)}
+	return cl.deep(val)
+}
+
+// cloneKey identifies already cloned pointers, maps and slices.
+type cloneKey struct {
+	typ reflect.Type   // Value type.
+	ptr unsafe.Pointer // Pointer to the underlying data.
+	len int            // Slice length.
+	cap int            // Slice capacity.
+}
+
+// cloner keeps the state of a single [Clone] call.
+type cloner struct {
+	seen map[cloneKey]reflect.Value // Already cloned values.
+	all  bool                       // Ignore the [MirrorTag] opt-outs.
+}
+
+// clone returns a deep copy of the value using its "Clone() T" method if it
+// has one.
+func (cl *cloner) clone(src reflect.Value) reflect.Value {
+	if idx := cloneMethod(src.Type()); idx >= 0 && !isNilValue(src) {
+		return src.Method(idx).Call(nil)[0]
+	}
+	return cl.deep(src)
+}
+
+// deep returns a deep copy of the value without checking for the "Clone() T"
+// method.
+func (cl *cloner) deep(src reflect.Value) reflect.Value {
+	typ := src.Type()
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return src
+		}
+		key := cloneKey{typ: typ, ptr: src.UnsafePointer()}
+		if dst, ok := cl.seen[key]; ok {
+			return dst
+		}
+		dst := reflect.New(typ.Elem())
+		cl.seen[key] = dst
+		dst.Elem().Set(cl.clone(src.Elem()))
+		return dst
+
+	case reflect.Interface:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.New(typ).Elem()
+		dst.Set(cl.clone(src.Elem()))
+		return dst
+
+	case reflect.Slice:
+		if src.IsNil() {
+			return src
+		}
+		key := cloneKey{
+			typ: typ,
+			ptr: src.UnsafePointer(),
+			len: src.Len(),
+			cap: src.Cap(),
+		}
+		if dst, ok := cl.seen[key]; ok {
+			return dst
+		}
+		dst := reflect.MakeSlice(typ, src.Len(), src.Cap())
+		cl.seen[key] = dst
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(cl.clone(src.Index(i)))
+		}
+		return dst
+
+	case reflect.Array:
+		dst := reflect.New(typ).Elem()
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(cl.clone(src.Index(i)))
+		}
+		return dst
+
+	case reflect.Map:
+		if src.IsNil() {
+			return src
+		}
+		key := cloneKey{typ: typ, ptr: src.UnsafePointer()}
+		if dst, ok := cl.seen[key]; ok {
+			return dst
+		}
+		dst := reflect.MakeMapWithSize(typ, src.Len())
+		cl.seen[key] = dst
+		iter := src.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(iter.Key(), cl.clone(iter.Value()))
+		}
+		return dst
+
+	case reflect.Struct:
+		dst := reflect.New(typ).Elem()
+		dst.Set(src)
+		for i, fld := range ReflectType(typ).Fields() {
+			if !fld.IsExported() {
+				continue
+			}
+			tag := fld.Tag(MirrorTag)
+			switch {
+			case cl.all:
+				dst.Field(i).Set(cl.clone(src.Field(i)))
+			case tag.IsIgnored():
+				dst.Field(i).SetZero()
+			case tag.Name() == "shallow" || tag.Contains("shallow"):
+				continue
+			default:
+				dst.Field(i).Set(cl.clone(src.Field(i)))
+			}
+		}
+		return dst
+
+	default:
+		return src
+	}
+}
+
+// cloneMethod returns the index of the "Clone() T" method, where T is the
+// type itself, or -1 if the type doesn't have it.
+func cloneMethod(typ reflect.Type) int {
+	if idx, ok := cloneMethods.Load(typ); ok {
+		return idx.(int)
+	}
+	idx := -1
+	if m, ok := typ.MethodByName("Clone"); ok {
+		in := 1 // The receiver.
+		if typ.Kind() == reflect.Interface {
+			in = 0
+		}
+		mt := m.Type
+		if mt.NumIn() == in && mt.NumOut() == 1 && mt.Out(0) == typ {
+			idx = m.Index
+		}
+	}
+	cloneMethods.Store(typ, idx)
+	return idx
+}
+
+// isNilValue returns true for nil pointers and interfaces.
+func isNilValue(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return val.IsNil()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TClone is a struct used in clone tests.
+type TClone struct {
+	Int   int
+	Ptr   *int
+	Slice []int
+	Map   map[string]*int
+	Arr   [2]*int
+	Any   any
+	Next  *TClone
+	priv  *int
+}
+
+// TCloneShallow is a struct used in clone tests.
+type TCloneShallow struct {
+	Deep    *int
+	Shallow *int `mirror:"shallow"`
+	Option  *int `mirror:",shallow"`
+	Skip    *int `mirror:"-"`
+}
+
+// TCloneMethod is a type with the Clone method.
+type TCloneMethod struct {
+	Val   *int
+	Calls int
+}
+
+func (c TCloneMethod) Clone() TCloneMethod {
+	c.Calls++
+	return c
+}
+
+// TCloneSelf is a type with the Clone method implemented using [Clone].
+type TCloneSelf struct{ Val *int }
+
+func (c TCloneSelf) Clone() TCloneSelf { return Clone(c) }
+
+// TCloneWrong is a type with the Clone method returning other type.
+type TCloneWrong struct{ Val *int }
+
+func (c TCloneWrong) Clone() any { return nil }
+
+func Test_Clone(t *testing.T) {
+	t.Run("scalar", func(t *testing.T) {
+		// --- When ---
+		have := Clone(42)
+
+		// --- Then ---
+		assert.Equal(t, 42, have)
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		// --- When ---
+		have := Clone[*TClone](nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("nil interface", func(t *testing.T) {
+		// --- When ---
+		have := Clone[any](nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		// --- Given ---
+		src := TClone{
+			Int:   1,
+			Ptr:   Ptr(2),
+			Slice: []int{3, 4},
+			Map:   map[string]*int{"a": Ptr(5)},
+			Arr:   [2]*int{Ptr(6), nil},
+			Any:   &TClone{Int: 7},
+		}
+
+		// --- When ---
+		have := Clone(src)
+
+		// --- Then ---
+		assert.Equal(t, src, have)
+		assert.NotSame(t, src.Ptr, have.Ptr)
+		assert.NotSame(t, src.Slice, have.Slice)
+		assert.NotSame(t, src.Map, have.Map)
+		assert.NotSame(t, src.Map["a"], have.Map["a"])
+		assert.NotSame(t, src.Arr[0], have.Arr[0])
+		assert.Nil(t, have.Arr[1])
+		assert.NotSame(t, src.Any, have.Any)
+	})
+
+	t.Run("pointer to struct", func(t *testing.T) {
+		// --- Given ---
+		src := &TClone{Int: 1, Ptr: Ptr(2)}
+
+		// --- When ---
+		have := Clone(src)
+
+		// --- Then ---
+		assert.Equal(t, src, have)
+		assert.NotSame(t, src, have)
+		assert.NotSame(t, src.Ptr, have.Ptr)
+	})
+
+	t.Run("nil containers stay nil", func(t *testing.T) {
+		// --- When ---
+		have := Clone(TClone{})
+
+		// --- Then ---
+		assert.Nil(t, have.Slice)
+		assert.Nil(t, have.Map)
+		assert.Nil(t, have.Any)
+	})
+
+	t.Run("preserves aliasing", func(t *testing.T) {
+		// --- Given ---
+		ptr := Ptr(1)
+		src := TClone{Ptr: ptr, Map: map[string]*int{"a": ptr}}
+
+		// --- When ---
+		have := Clone(src)
+
+		// --- Then ---
+		assert.NotSame(t, ptr, have.Ptr)
+		assert.Same(t, have.Ptr, have.Map["a"])
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		src := &TClone{Int: 1}
+		src.Next = &TClone{Int: 2, Next: src}
+
+		// --- When ---
+		have := Clone(src)
+
+		// --- Then ---
+		assert.NotSame(t, src, have)
+		assert.Equal(t, 2, have.Next.Int)
+		assert.Same(t, have, have.Next.Next)
+	})
+
+	t.Run("unexported fields are copied as is", func(t *testing.T) {
+		// --- Given ---
+		src := TClone{priv: Ptr(1)}
+
+		// --- When ---
+		have := Clone(src)
+
+		// --- Then ---
+		assert.Same(t, src.priv, have.priv)
+	})
+
+	t.Run("tag options", func(t *testing.T) {
+		// --- Given ---
+		src := TCloneShallow{
+			Deep:    Ptr(1),
+			Shallow: Ptr(2),
+			Option:  Ptr(3),
+			Skip:    Ptr(4),
+		}
+
+		// --- When ---
+		have := Clone(src)
+
+		// --- Then ---
+		assert.Equal(t, 1, *have.Deep)
+		assert.NotSame(t, src.Deep, have.Deep)
+		assert.Same(t, src.Shallow, have.Shallow)
+		assert.Same(t, src.Option, have.Option)
+		assert.Nil(t, have.Skip)
+	})
+
+	t.Run("nested value with Clone method", func(t *testing.T) {
+		// --- Given ---
+		val := Ptr(1)
+		src := []TCloneMethod{{Val: val}}
+
+		// --- When ---
+		have := Clone(src)
+
+		// --- Then ---
+		assert.Equal(t, 1, have[0].Calls)
+		assert.Same(t, val, have[0].Val)
+	})
+
+	t.Run("Clone method not used for the value itself", func(t *testing.T) {
+		// --- Given ---
+		src := TCloneMethod{Val: Ptr(1)}
+
+		// --- When ---
+		have := Clone(src)
+
+		// --- Then ---
+		assert.Equal(t, 0, have.Calls)
+		assert.NotSame(t, src.Val, have.Val)
+	})
+
+	t.Run("Clone method implemented with Clone", func(t *testing.T) {
+		// --- Given ---
+		src := TCloneSelf{Val: Ptr(1)}
+
+		// --- When ---
+		have := Clone(src.Clone())
+
+		// --- Then ---
+		assert.Equal(t, 1, *have.Val)
+		assert.NotSame(t, src.Val, have.Val)
+	})
+
+	t.Run("Clone method with wrong signature", func(t *testing.T) {
+		// --- Given ---
+		src := []TCloneWrong{{Val: Ptr(1)}}
+
+		// --- When ---
+		have := Clone(src)
+
+		// --- Then ---
+		assert.Equal(t, 1, *have[0].Val)
+		assert.NotSame(t, src[0].Val, have[0].Val)
+	})
+
+	t.Run("time", func(t *testing.T) {
+		// --- Given ---
+		now := time.Now()
+
+		// --- When ---
+		have := Clone(now)
+
+		// --- Then ---
+		assert.True(t, now.Equal(have))
+	})
+}
+
+func Test_cloneMethod(t *testing.T) {
+	t.Run("with method", func(t *testing.T) {
+		// --- When ---
+		have := cloneMethod(reflect.TypeOf(TCloneMethod{}))
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+	})
+
+	t.Run("pointer to type with value method", func(t *testing.T) {
+		// --- When ---
+		have := cloneMethod(reflect.TypeOf(&TCloneMethod{}))
+
+		// --- Then ---
+		assert.Equal(t, -1, have)
+	})
+
+	t.Run("wrong signature", func(t *testing.T) {
+		// --- When ---
+		have := cloneMethod(reflect.TypeOf(TCloneWrong{}))
+
+		// --- Then ---
+		assert.Equal(t, -1, have)
+	})
+
+	t.Run("without method", func(t *testing.T) {
+		// --- When ---
+		have := cloneMethod(reflect.TypeOf(TClone{}))
+
+		// --- Then ---
+		assert.Equal(t, -1, have)
+	})
+}
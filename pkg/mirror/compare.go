@@ -0,0 +1,513 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// DefaultCompareTag is the default struct tag key with comparison options.
+// Fields with the "-" name are not compared, for example, `cmp:"-"`.
+const DefaultCompareTag = "cmp"
+
+// equalMethods caches the index of the "Equal(T) bool" method for types. The
+// index is -1 for types without the method.
+var equalMethods sync.Map
+
+// CompareOption represents [Diff] and [Equal] option.
+type CompareOption func(*compareOpts)
+
+// WithCompareTag sets the struct tag key used to ignore fields. Fields with
+// the "-" tag name are not compared. By default, it is the "cmp" key.
+func WithCompareTag(key string) CompareOption {
+	return func(opts *compareOpts) { opts.tagKey = key }
+}
+
+// WithCompareNaming sets the naming policy used to construct
+// [Change.TagPath]. By default, the "json" tag is used.
+func WithCompareNaming(np NamingPolicy) CompareOption {
+	return func(opts *compareOpts) { opts.naming = np }
+}
+
+// WithIgnorePath ignores values at the given paths. Paths use Go field names
+// and the "[*]" element matches any slice, array index or map key, for
+// example, "Items[*].UpdatedAt".
+func WithIgnorePath(paths ...string) CompareOption {
+	return func(opts *compareOpts) {
+		opts.ignore = append(opts.ignore, paths...)
+	}
+}
+
+// WithSliceKey matches elements of slices at the given path by the value of
+// the struct field instead of by index. The path follows the same rules as
+// in [WithIgnorePath]. The field name is a Go name of a field in the slice
+// element struct (or pointer to struct). Key values should be unique and
+// comparable; elements without a key are matched by index.
+func WithSliceKey(path, field string) CompareOption {
+	return func(opts *compareOpts) {
+		opts.keys = append(opts.keys, sliceKey{path: path, field: field})
+	}
+}
+
+// WithUnexported includes unexported struct fields in the comparison.
+func WithUnexported() CompareOption {
+	return func(opts *compareOpts) { opts.unexported = true }
+}
+
+// WithTypeEqual sets the function used to compare values of type T. The
+// function takes precedence over the "Equal(T) bool" method.
+func WithTypeEqual[T any](fn func(a, b T) bool) CompareOption {
+	typ := reflect.TypeFor[T]()
+	return func(opts *compareOpts) {
+		if opts.types == nil {
+			opts.types = make(map[reflect.Type]func(a, b reflect.Value) bool)
+		}
+		opts.types[typ] = func(a, b reflect.Value) bool {
+			av, _ := a.Interface().(T) // Zero value for nil interfaces.
+			bv, _ := b.Interface().(T)
+			return fn(av, bv)
+		}
+	}
+}
+
+// WithFloatEpsilon makes floating point numbers equal when they differ by
+// no more than epsilon.
+func WithFloatEpsilon(epsilon float64) CompareOption {
+	return func(opts *compareOpts) { opts.epsilon = epsilon }
+}
+
+// WithNilEmptyEqual makes nil and empty slices and maps equal.
+func WithNilEmptyEqual() CompareOption {
+	return func(opts *compareOpts) { opts.nilEmpty = true }
+}
+
+// compareOpts represents options for comparing values.
+type compareOpts struct {
+	tagKey     string       // Struct tag key with comparison options.
+	naming     NamingPolicy // Naming policy for tag paths.
+	ignore     []string     // Ignored path patterns.
+	keys       []sliceKey   // Key fields for slices.
+	unexported bool         // Compare unexported fields.
+	epsilon    float64      // Tolerance for floating point numbers.
+	nilEmpty   bool         // Nil and empty slices and maps are equal.
+
+	// Functions comparing values of given types.
+	types map[reflect.Type]func(a, b reflect.Value) bool
+}
+
+// newCompareOpts returns options with defaults and given options applied.
+func newCompareOpts(opts ...CompareOption) *compareOpts {
+	ops := &compareOpts{
+		tagKey: DefaultCompareTag,
+		naming: TagPolicy("json"),
+	}
+	for _, opt := range opts {
+		opt(ops)
+	}
+	return ops
+}
+
+// isIgnored returns true if the path matches any of the ignored paths.
+func (opts *compareOpts) isIgnored(path string) bool {
+	for _, pattern := range opts.ignore {
+		if matchPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// sliceKey returns the name of key field for the slice path. Returns empty
+// string when elements of the slice are matched by index.
+func (opts *compareOpts) sliceKey(path string) string {
+	for _, sk := range opts.keys {
+		if matchPath(sk.path, path) {
+			return sk.field
+		}
+	}
+	return ""
+}
+
+// sliceKey represents the key field for matching slice elements.
+type sliceKey struct {
+	path  string // Slice path pattern.
+	field string // Key field name.
+}
+
+// visit represents a pair of pointers, maps or slices compared on the
+// current path.
+type visit struct {
+	a, b unsafe.Pointer // Compared pointers.
+	typ  reflect.Type   // Pointers type.
+}
+
+// comparer represents a single comparison of two values.
+type comparer struct {
+	opts    *compareOpts   // Comparison options.
+	first   bool           // Stop at the first change.
+	visited map[visit]bool // Pointers on the current path.
+	changes []Change       // Found changes.
+}
+
+// newComparer returns new instance of comparer.
+func newComparer(opts *compareOpts, first bool) *comparer {
+	return &comparer{
+		opts:    opts,
+		first:   first,
+		visited: make(map[visit]bool),
+	}
+}
+
+// done returns true when the comparison should stop.
+func (c *comparer) done() bool { return c.first && len(c.changes) > 0 }
+
+// report records a change.
+func (c *comparer) report(a, b reflect.Value, path, tagPath string) {
+	c.changes = append(c.changes, Change{
+		Path:    path,
+		TagPath: tagPath,
+		Old:     valueOf(a),
+		New:     valueOf(b),
+	})
+}
+
+// compare compares two values recording changes.
+//
+// nolint: cyclop
+func (c *comparer) compare(a, b reflect.Value, path, tagPath string) {
+	if c.done() || c.opts.isIgnored(path) {
+		return
+	}
+	if !a.IsValid() || !b.IsValid() || a.Type() != b.Type() {
+		if a.IsValid() || b.IsValid() {
+			c.report(a, b, path, tagPath)
+		}
+		return
+	}
+	if fn, ok := c.opts.types[a.Type()]; ok {
+		if !fn(a, b) {
+			c.report(a, b, path, tagPath)
+		}
+		return
+	}
+	aNil, bNil := isNilable(a) && a.IsNil(), isNilable(b) && b.IsNil()
+	if aNil || bNil {
+		if aNil != bNil && !c.nilEmpty(a, b) {
+			c.report(a, b, path, tagPath)
+		}
+		return
+	}
+	if idx := equalMethod(a.Type()); idx >= 0 {
+		if !a.Method(idx).Call([]reflect.Value{b})[0].Bool() {
+			c.report(a, b, path, tagPath)
+		}
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.Pointer() == b.Pointer() {
+			return
+		}
+		if !c.enter(a, b) {
+			return // Cycle.
+		}
+		defer c.leave(a, b)
+		c.compare(a.Elem(), b.Elem(), path, tagPath)
+
+	case reflect.Interface:
+		c.compare(a.Elem(), b.Elem(), path, tagPath)
+
+	case reflect.Struct:
+		if !c.opts.unexported && !isMergeStruct(a.Type()) {
+			if !opaqueEqual(a, b) {
+				c.report(a, b, path, tagPath)
+			}
+			return
+		}
+		c.compareStruct(a, b, path, tagPath)
+
+	case reflect.Slice:
+		if !c.enter(a, b) {
+			return // Cycle.
+		}
+		defer c.leave(a, b)
+		if field := c.opts.sliceKey(path); field != "" {
+			if c.compareKeyed(a, b, field, path, tagPath) {
+				return
+			}
+		}
+		c.compareIndexed(a, b, path, tagPath)
+
+	case reflect.Array:
+		c.compareIndexed(a, b, path, tagPath)
+
+	case reflect.Map:
+		if !c.enter(a, b) {
+			return // Cycle.
+		}
+		defer c.leave(a, b)
+		c.compareMap(a, b, path, tagPath)
+
+	case reflect.Float32, reflect.Float64:
+		if math.Abs(a.Float()-b.Float()) > c.opts.epsilon ||
+			math.IsNaN(a.Float()) || math.IsNaN(b.Float()) {
+			c.report(a, b, path, tagPath)
+		}
+
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if a.Pointer() != b.Pointer() {
+			c.report(a, b, path, tagPath)
+		}
+
+	default:
+		if !a.Equal(b) {
+			c.report(a, b, path, tagPath)
+		}
+	}
+}
+
+// enter marks the pair of pointers, maps or slices as compared on the current
+// path. Returns false if the pair is already compared, which means the values
+// are cyclic.
+func (c *comparer) enter(a, b reflect.Value) bool {
+	v := visit{a: a.UnsafePointer(), b: b.UnsafePointer(), typ: a.Type()}
+	if c.visited[v] {
+		return false
+	}
+	c.visited[v] = true
+	return true
+}
+
+// leave removes the pair marked with [comparer.enter].
+func (c *comparer) leave(a, b reflect.Value) {
+	delete(c.visited, visit{
+		a:   a.UnsafePointer(),
+		b:   b.UnsafePointer(),
+		typ: a.Type(),
+	})
+}
+
+// nilEmpty returns true when nil and empty slices and maps are equal and
+// both values are nil or empty.
+func (c *comparer) nilEmpty(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Slice, reflect.Map:
+		return c.opts.nilEmpty && a.Len() == 0 && b.Len() == 0
+	default:
+		return false
+	}
+}
+
+// compareStruct compares struct fields.
+func (c *comparer) compareStruct(a, b reflect.Value, path, tagPath string) {
+	if c.opts.unexported {
+		a, b = addressable(a), addressable(b)
+	}
+	for i, fld := range ReflectType(a.Type()).Fields() {
+		if fld.Tag(c.opts.tagKey).IsIgnored() {
+			continue
+		}
+		fa, fb := a.Field(i), b.Field(i)
+		if !fld.IsExported() {
+			if !c.opts.unexported {
+				continue
+			}
+			fa, fb = exportValue(fa), exportValue(fb)
+		}
+		fTagPath := tagPath
+		if !fld.IsAnonymous() || c.opts.naming.IsNamed(fld) {
+			fTagPath = joinPath(tagPath, c.opts.naming.Name(fld))
+		}
+		c.compare(fa, fb, joinPath(path, fld.Name()), fTagPath)
+	}
+}
+
+// opaqueEqual returns true when structs without exported fields are equal.
+// Comparable values are compared with the == operator, other values with
+// [reflect.DeepEqual].
+func opaqueEqual(a, b reflect.Value) bool {
+	if a.Comparable() && b.Comparable() {
+		return a.Equal(b)
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// compareIndexed compares slice or array elements by index.
+func (c *comparer) compareIndexed(a, b reflect.Value, path, tagPath string) {
+	for i := 0; i < max(a.Len(), b.Len()); i++ {
+		var ea, eb reflect.Value
+		if i < a.Len() {
+			ea = a.Index(i)
+		}
+		if i < b.Len() {
+			eb = b.Index(i)
+		}
+		c.compare(ea, eb, indexPath(path, i), indexPath(tagPath, i))
+	}
+}
+
+// compareKeyed compares slice elements matched by the key field. Returns
+// false when any of the elements has no key, in which case nothing is
+// compared.
+func (c *comparer) compareKeyed(
+	a, b reflect.Value,
+	field, path, tagPath string,
+) bool {
+
+	ak, ok := elementKeys(a, field)
+	if !ok {
+		return false
+	}
+	bk, ok := elementKeys(b, field)
+	if !ok {
+		return false
+	}
+
+	idx := make(map[any]int, len(bk))
+	for i, key := range bk {
+		if _, ok := idx[key.Interface()]; !ok {
+			idx[key.Interface()] = i
+		}
+	}
+	matched := make(map[int]bool, len(bk))
+	for i, key := range ak {
+		var eb reflect.Value
+		if j, ok := idx[key.Interface()]; ok && !matched[j] {
+			eb = b.Index(j)
+			matched[j] = true
+		}
+		c.compare(a.Index(i), eb, keyPath(path, key), keyPath(tagPath, key))
+	}
+	for j, key := range bk {
+		if !matched[j] {
+			c.compare(
+				reflect.Value{},
+				b.Index(j),
+				keyPath(path, key),
+				keyPath(tagPath, key),
+			)
+		}
+	}
+	return true
+}
+
+// compareMap compares map values with the same keys.
+func (c *comparer) compareMap(a, b reflect.Value, path, tagPath string) {
+	keys := sortedKeys(a)
+	for _, key := range sortedKeys(b) {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		c.compare(
+			a.MapIndex(key),
+			b.MapIndex(key),
+			keyPath(path, key),
+			keyPath(tagPath, key),
+		)
+	}
+}
+
+// elementKeys returns values of the key field for all slice elements. It
+// returns false when any of the elements has no comparable key field.
+func elementKeys(val reflect.Value, field string) ([]reflect.Value, bool) {
+	keys := make([]reflect.Value, val.Len())
+	for i := range keys {
+		elem := val.Index(i)
+		if elem.Kind() == reflect.Ptr {
+			if elem.IsNil() {
+				return nil, false
+			}
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.Struct {
+			return nil, false
+		}
+		key := elem.FieldByName(field)
+		if !key.IsValid() || !key.CanInterface() || !key.Comparable() {
+			return nil, false
+		}
+		keys[i] = key
+	}
+	return keys, true
+}
+
+// equalMethod returns the index of the "Equal(T) bool" method, where T is
+// the type itself, or -1 if the type doesn't have it.
+func equalMethod(typ reflect.Type) int {
+	if idx, ok := equalMethods.Load(typ); ok {
+		return idx.(int)
+	}
+	idx := -1
+	if m, ok := typ.MethodByName("Equal"); ok {
+		in := 1 // The receiver.
+		if typ.Kind() == reflect.Interface {
+			in = 0
+		}
+		mt := m.Type
+		if mt.NumIn() == in+1 && mt.In(in) == typ &&
+			mt.NumOut() == 1 && mt.Out(0).Kind() == reflect.Bool {
+			idx = m.Index
+		}
+	}
+	equalMethods.Store(typ, idx)
+	return idx
+}
+
+// matchPath returns true if the path matches the pattern. The "[*]" element
+// in the pattern matches any index or key.
+func matchPath(pattern, path string) bool {
+	for pattern != "" {
+		if strings.HasPrefix(pattern, "[*]") {
+			if path == "" || path[0] != '[' {
+				return false
+			}
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return false
+			}
+			pattern, path = pattern[3:], path[end+1:]
+			continue
+		}
+		if path == "" || pattern[0] != path[0] {
+			return false
+		}
+		pattern, path = pattern[1:], path[1:]
+	}
+	return path == ""
+}
+
+// isNilable returns true for kinds which may be nil.
+func isNilable(val reflect.Value) bool { return isNilableKind(val.Kind()) }
+
+// addressable returns an addressable copy of the value if the value is not
+// addressable.
+func addressable(val reflect.Value) reflect.Value {
+	if val.CanAddr() {
+		return val
+	}
+	cpy := reflect.New(val.Type()).Elem()
+	cpy.Set(val)
+	return cpy
+}
+
+// exportValue returns the value of an unexported field of addressable struct
+// which can be used as an exported one.
+func exportValue(val reflect.Value) reflect.Value {
+	return reflect.NewAt(val.Type(), unsafe.Pointer(val.UnsafeAddr())).Elem()
+}
+
+// valueOf returns the value as interface or nil for invalid values.
+func valueOf(val reflect.Value) any {
+	if !val.IsValid() || !val.CanInterface() {
+		return nil
+	}
+	return val.Interface()
+}
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_newCompareOpts(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// --- When ---
+		have := newCompareOpts()
+
+		// --- Then ---
+		assert.Equal(t, DefaultCompareTag, have.tagKey)
+		assert.Equal(t, TagPolicy("json"), have.naming)
+		assert.Nil(t, have.ignore)
+		assert.Nil(t, have.keys)
+		assert.False(t, have.unexported)
+		assert.Equal(t, 0.0, have.epsilon)
+		assert.False(t, have.nilEmpty)
+		assert.Nil(t, have.types)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- When ---
+		have := newCompareOpts(
+			WithCompareTag("diff"),
+			WithCompareNaming(TagPolicy("db")),
+			WithIgnorePath("A", "B"),
+			WithSliceKey("C", "ID"),
+			WithUnexported(),
+			WithFloatEpsilon(0.1),
+			WithNilEmptyEqual(),
+			WithTypeEqual(func(a, b int) bool { return true }),
+		)
+
+		// --- Then ---
+		assert.Equal(t, "diff", have.tagKey)
+		assert.Equal(t, TagPolicy("db"), have.naming)
+		assert.Equal(t, []string{"A", "B"}, have.ignore)
+		assert.Equal(t, []sliceKey{{path: "C", field: "ID"}}, have.keys)
+		assert.True(t, have.unexported)
+		assert.Equal(t, 0.1, have.epsilon)
+		assert.True(t, have.nilEmpty)
+		assert.Len(t, 1, have.types)
+	})
+}
+
+func Test_compareOpts_sliceKey(t *testing.T) {
+	// --- Given ---
+	opts := newCompareOpts(WithSliceKey("A[*].B", "ID"))
+
+	// --- Then ---
+	assert.Equal(t, "ID", opts.sliceKey("A[1].B"))
+	assert.Equal(t, "", opts.sliceKey("A"))
+}
+
+func Test_matchPath_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"empty", "", "", true},
+		{"same", "A.B", "A.B", true},
+		{"different", "A.B", "A.C", false},
+		{"prefix", "A", "A.B", false},
+		{"longer pattern", "A.B", "A", false},
+		{"index", "A[1]", "A[1]", true},
+		{"wildcard index", "A[*].B", "A[12].B", true},
+		{"wildcard key", "A[*]", "A[key]", true},
+		{"wildcard not index", "A[*]", "A.B", false},
+		{"wildcard at end", "A[*]", "A", false},
+		{"wildcard not terminated", "A[*]", "A[1", false},
+		{"root wildcard", "[*].A", "[0].A", true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := matchPath(tc.pattern, tc.path)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_equalMethod(t *testing.T) {
+	t.Run("with method", func(t *testing.T) {
+		// --- When ---
+		have := equalMethod(reflect.TypeOf(time.Time{}))
+
+		// --- Then ---
+		assert.True(t, have >= 0)
+	})
+
+	t.Run("pointer to type with value method", func(t *testing.T) {
+		// --- When ---
+		have := equalMethod(reflect.TypeOf(&time.Time{}))
+
+		// --- Then ---
+		assert.Equal(t, -1, have)
+	})
+
+	t.Run("without method", func(t *testing.T) {
+		// --- When ---
+		have := equalMethod(reflect.TypeOf(TDiff{}))
+
+		// --- Then ---
+		assert.Equal(t, -1, have)
+	})
+}
+
+func Test_elementKeys(t *testing.T) {
+	t.Run("structs", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf([]TDiffItem{{1, 10}, {2, 20}})
+
+		// --- When ---
+		have, ok := elementKeys(val, "ID")
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Len(t, 2, have)
+		assert.Equal(t, 1, have[0].Interface())
+		assert.Equal(t, 2, have[1].Interface())
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf([]TDiffItem{{1, 10}})
+
+		// --- When ---
+		have, ok := elementKeys(val, "Other")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Nil(t, have)
+	})
+
+	t.Run("not structs", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf([]int{1})
+
+		// --- When ---
+		have, ok := elementKeys(val, "ID")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Nil(t, have)
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf([]*TDiffItem{nil})
+
+		// --- When ---
+		have, ok := elementKeys(val, "ID")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_exportValue(t *testing.T) {
+	// --- Given ---
+	s := &TDiff{priv: 42}
+	fld := reflect.ValueOf(s).Elem().FieldByName("priv")
+
+	// --- When ---
+	have := exportValue(fld)
+
+	// --- Then ---
+	assert.True(t, have.CanInterface())
+	assert.Equal(t, 42, have.Interface())
+}
+
+func Test_addressable(t *testing.T) {
+	t.Run("not addressable", func(t *testing.T) {
+		// --- When ---
+		have := addressable(reflect.ValueOf(TDiff{Age: 1}))
+
+		// --- Then ---
+		assert.True(t, have.CanAddr())
+		assert.Equal(t, TDiff{Age: 1}, have.Interface())
+	})
+
+	t.Run("addressable", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf(&TDiff{Age: 1}).Elem()
+
+		// --- When ---
+		have := addressable(val)
+
+		// --- Then ---
+		assert.Equal(t, val.UnsafeAddr(), have.UnsafeAddr())
+	})
+}
+
+func Test_valueOf(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		have := valueOf(reflect.ValueOf(1))
+
+		// --- Then ---
+		assert.Equal(t, 1, have)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		// --- When ---
+		have := valueOf(reflect.Value{})
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
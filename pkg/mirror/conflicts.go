@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TagConflict represents struct fields sharing the same effective tag name.
+type TagConflict struct {
+	Type   reflect.Type // The struct type.
+	Key    string       // Tag key.
+	Name   string       // The conflicting name.
+	Fields []string     // Paths to conflicting fields using Go field names.
+
+	// Shadowed is true when the conflict is resolved by the Go field
+	// dominance rules, so one of the fields shadows the others.
+	Shadowed bool
+}
+
+func (tc TagConflict) String() string {
+	var shadowed string
+	if tc.Shadowed {
+		shadowed = " (shadowed)"
+	}
+	return fmt.Sprintf(
+		"%s: %s:%q used by %s%s",
+		tc.Type,
+		tc.Key,
+		tc.Name,
+		strings.Join(tc.Fields, ", "),
+		shadowed,
+	)
+}
+
+// TagConflicts returns fields of the struct sharing the same effective name
+// ([Tag.NameOrField]) for the tag key. Both direct fields and fields promoted
+// from embedded structs are considered. Embedded structs with a tag name are
+// treated as regular fields. Unexported and ignored fields are skipped.
+//
+// Conflicts which are resolved by the Go field dominance rules, the same way
+// as encoding/json does it, are reported with [TagConflict.Shadowed] set: a
+// field at a shallower embedding depth shadows fields with the same name at
+// deeper levels, and among fields at the same depth the only one with the
+// name set explicitly in the tag wins. It returns nil when there are no
+// conflicts or the type is not a struct.
+func (md *Metadata) TagConflicts(key string) []TagConflict {
+	if !md.IsStruct() {
+		return nil
+	}
+
+	var names []string
+	fields := make(map[string][]namedField)
+	seen := map[reflect.Type]bool{md.typ: true}
+	md.collectNames(key, "", 0, seen, func(name string, nf namedField) {
+		if _, ok := fields[name]; !ok {
+			names = append(names, name)
+		}
+		fields[name] = append(fields[name], nf)
+	})
+
+	var conflicts []TagConflict
+	for _, name := range names {
+		nfs := fields[name]
+		if len(nfs) < 2 {
+			continue
+		}
+		tc := TagConflict{Type: md.typ, Key: key, Name: name}
+		if tc.Fields = dominantConflict(nfs); tc.Fields == nil {
+			tc.Shadowed = true
+			for _, nf := range nfs {
+				tc.Fields = append(tc.Fields, nf.path)
+			}
+		}
+		conflicts = append(conflicts, tc)
+	}
+	return conflicts
+}
+
+// namedField represents a field found by [Metadata.collectNames].
+type namedField struct {
+	path   string // Path to the field using Go field names.
+	depth  int    // Embedding depth, zero for direct fields.
+	tagged bool   // The name is set explicitly in the tag.
+}
+
+// dominantConflict returns paths of the fields with the same name which
+// cannot be resolved using the Go field dominance rules. It returns nil when
+// there is a dominant field.
+func dominantConflict(fields []namedField) []string {
+	depth := fields[0].depth
+	for _, nf := range fields {
+		depth = min(depth, nf.depth)
+	}
+	var paths []string
+	var tagged int
+	for _, nf := range fields {
+		if nf.depth != depth {
+			continue
+		}
+		paths = append(paths, nf.path)
+		if nf.tagged {
+			tagged++
+		}
+	}
+	if len(paths) == 1 || tagged == 1 {
+		return nil
+	}
+	return paths
+}
+
+// collectNames calls "fn" with the effective tag name and the field details
+// for all direct and promoted struct fields.
+func (md *Metadata) collectNames(
+	key, path string,
+	depth int,
+	seen map[reflect.Type]bool,
+	fn func(name string, nf namedField),
+) {
+
+	for _, fld := range md.fields {
+		tag := fld.Tag(key)
+		if tag.IsIgnored() {
+			continue
+		}
+		fPath := joinPath(path, fld.Name())
+		if fld.IsAnonymous() && fld.IsStruct() && tag.Name() == "" {
+			typ := fld.TypeMetadata().Type()
+			if seen[typ] {
+				continue
+			}
+			seen[typ] = true
+			fld.TypeMetadata().collectNames(key, fPath, depth+1, seen, fn)
+			delete(seen, typ)
+			continue
+		}
+		if !fld.IsExported() {
+			continue
+		}
+		nf := namedField{path: fPath, depth: depth, tagged: tag.hasName()}
+		fn(tag.NameOrField(), nf)
+	}
+}
+
+// FindTagConflicts walks the type graph of "v" and returns tag name conflicts
+// for the given tag keys in all reachable struct types. Struct types are
+// reached through struct fields, pointers, slices, arrays and maps. It
+// returns nil when there are no conflicts.
+//
+// The function is meant to be used in unit tests guarding API models:
+//
+//	conflicts := FindTagConflicts(&User{}, "json", "db")
+func FindTagConflicts(v any, keys ...string) []TagConflict {
+	var conflicts []TagConflict
+	walkStructs(reflect.TypeOf(v), func(md *Metadata) {
+		for _, key := range keys {
+			conflicts = append(conflicts, md.TagConflicts(key)...)
+		}
+	})
+	return conflicts
+}
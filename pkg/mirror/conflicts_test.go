@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TConflictBase is a struct used in tag conflict tests.
+type TConflictBase struct {
+	ID      int    `json:"id"`
+	Created string `json:"created"`
+}
+
+// TConflictLoop is a self embedding struct used in tag conflict tests.
+type TConflictLoop struct {
+	*TConflictLoop
+	Name string `json:"name"`
+}
+
+// TConflict is a struct used in tag conflict tests.
+type TConflict struct {
+	TConflictBase
+	Key     string            `json:"id"`
+	Name    string            `json:"name" api:"name"`
+	Title   string            `json:"title" api:"name"`
+	Skip    string            `json:"-"`
+	Other   string            `json:"-"`
+	Items   []*TConflictItem  `json:"items"`
+	Lookup  map[string]TwoStr `json:"lookup"`
+	private string
+}
+
+// TConflictItem is a struct used in tag conflict tests.
+type TConflictItem struct {
+	A string `api:"x" db:"a"`
+	B string `api:"x" db:"a"`
+}
+
+func Test_TagConflict_String(t *testing.T) {
+	t.Run("conflict", func(t *testing.T) {
+		// --- Given ---
+		tc := TagConflict{
+			Type:   reflect.TypeOf(TConflict{}),
+			Key:    "api",
+			Name:   "name",
+			Fields: []string{"Name", "Title"},
+		}
+
+		// --- When ---
+		have := tc.String()
+
+		// --- Then ---
+		want := `mirror.TConflict: api:"name" used by Name, Title`
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("shadowed", func(t *testing.T) {
+		// --- Given ---
+		tc := TagConflict{
+			Type:     reflect.TypeOf(TConflict{}),
+			Key:      "json",
+			Name:     "id",
+			Fields:   []string{"TConflictBase.ID", "Key"},
+			Shadowed: true,
+		}
+
+		// --- When ---
+		have := tc.String()
+
+		// --- Then ---
+		want := `mirror.TConflict: json:"id" used by TConflictBase.ID, Key` +
+			" (shadowed)"
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_Metadata_TagConflicts(t *testing.T) {
+	t.Run("shallower field shadows promoted", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TConflict{})
+
+		// --- When ---
+		have := md.TagConflicts("json")
+
+		// --- Then ---
+		want := []TagConflict{
+			{
+				Type:     reflect.TypeOf(TConflict{}),
+				Key:      "json",
+				Name:     "id",
+				Fields:   []string{"TConflictBase.ID", "Key"},
+				Shadowed: true,
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("promoted at the same depth", func(t *testing.T) {
+		// --- Given ---
+		type A struct {
+			X string `api:"id"`
+		}
+		type B struct {
+			Y string `api:"id"`
+		}
+		s := struct {
+			A
+			B
+		}{}
+		md := NewMetadata(s)
+
+		// --- When ---
+		have := md.TagConflicts("api")
+
+		// --- Then ---
+		want := []TagConflict{
+			{
+				Type:   reflect.TypeOf(s),
+				Key:    "api",
+				Name:   "id",
+				Fields: []string{"A.X", "B.Y"},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("tagged field wins at the same depth", func(t *testing.T) {
+		// --- Given ---
+		type Tagged struct {
+			ID int `json:"ID"`
+		}
+		type Untagged struct{ ID int }
+		s := struct {
+			Tagged
+			Untagged
+		}{}
+		md := NewMetadata(s)
+
+		// --- When ---
+		have := md.TagConflicts("json")
+
+		// --- Then ---
+		want := []TagConflict{
+			{
+				Type:     reflect.TypeOf(s),
+				Key:      "json",
+				Name:     "ID",
+				Fields:   []string{"Tagged.ID", "Untagged.ID"},
+				Shadowed: true,
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("conflict shadowed by shallower field", func(t *testing.T) {
+		// --- Given ---
+		type A struct {
+			X string `api:"id"`
+		}
+		type B struct {
+			Y string `api:"id"`
+		}
+		type Deep struct {
+			A
+			B
+		}
+		s := struct {
+			Deep
+			ID int `api:"id"`
+		}{}
+		md := NewMetadata(s)
+
+		// --- When ---
+		have := md.TagConflicts("api")
+
+		// --- Then ---
+		want := []TagConflict{
+			{
+				Type:     reflect.TypeOf(s),
+				Key:      "api",
+				Name:     "id",
+				Fields:   []string{"Deep.A.X", "Deep.B.Y", "ID"},
+				Shadowed: true,
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("direct", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TConflict{})
+
+		// --- When ---
+		have := md.TagConflicts("api")
+
+		// --- Then ---
+		want := []TagConflict{
+			{
+				Type:   reflect.TypeOf(TConflict{}),
+				Key:    "api",
+				Name:   "name",
+				Fields: []string{"Name", "Title"},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("tagged name wins over field name", func(t *testing.T) {
+		// --- Given ---
+		s := struct {
+			A string `yaml:",omitempty"`
+			B string `yaml:"A"`
+			C string `yaml:"B"`
+		}{}
+		md := NewMetadata(s)
+
+		// --- When ---
+		have := md.TagConflicts("yaml")
+
+		// --- Then ---
+		want := []TagConflict{
+			{
+				Type:     reflect.TypeOf(s),
+				Key:      "yaml",
+				Name:     "A",
+				Fields:   []string{"A", "B"},
+				Shadowed: true,
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("embedded struct with tag name is a field", func(t *testing.T) {
+		// --- Given ---
+		s := struct {
+			TConflictBase `json:"base"`
+			ID            int `json:"id"`
+		}{}
+		md := NewMetadata(s)
+
+		// --- When ---
+		have := md.TagConflicts("json")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("self embedding struct", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TConflictLoop{})
+
+		// --- When ---
+		have := md.TagConflicts("json")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("no conflicts", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TConflictBase{})
+
+		// --- When ---
+		have := md.TagConflicts("json")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(42)
+
+		// --- When ---
+		have := md.TagConflicts("json")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_FindTagConflicts(t *testing.T) {
+	t.Run("walks type graph", func(t *testing.T) {
+		// --- When ---
+		have := FindTagConflicts(&TConflict{}, "json", "db")
+
+		// --- Then ---
+		assert.Len(t, 2, have)
+		assert.Equal(t, reflect.TypeOf(TConflict{}), have[0].Type)
+		assert.True(t, have[0].Shadowed)
+		assert.Equal(t, reflect.TypeOf(TConflictItem{}), have[1].Type)
+		assert.Equal(t, "db", have[1].Key)
+		assert.Equal(t, "a", have[1].Name)
+		assert.False(t, have[1].Shadowed)
+	})
+
+	t.Run("map key and value", func(t *testing.T) {
+		// --- Given ---
+		var m map[TConflictItem][]TConflictItem
+
+		// --- When ---
+		have := FindTagConflicts(m, "api")
+
+		// --- Then ---
+		assert.Len(t, 1, have)
+	})
+
+	t.Run("recursive type", func(t *testing.T) {
+		// --- When ---
+		have := FindTagConflicts(TConflictLoop{}, "json")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("no conflicts", func(t *testing.T) {
+		// --- When ---
+		have := FindTagConflicts(&TStruct{}, "json")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Change represents a difference between two values.
+type Change struct {
+	Path    string // Path using Go field names.
+	TagPath string // Path using tag names.
+	Old     any    // Old value, nil when the value was added.
+	New     any    // New value, nil when the value was removed.
+}
+
+// String returns change description.
+func (ch Change) String() string {
+	path := ch.Path
+	if path == "" {
+		path = "<root>"
+	}
+	return fmt.Sprintf("%s: %v -> %v", path, ch.Old, ch.New)
+}
+
+// Diff returns changes between values "a" and "b" or nil when they are the
+// same. Nested structs, pointers, interfaces, slices, arrays and maps are
+// compared recursively; other values are compared with the "==" operator.
+//
+// Values of types with the "Equal(T) bool" method, where T is the type
+// itself, are compared by calling it, for example, [time.Time].
+//
+// Slice and array elements are matched by index, elements which exist only
+// in one of the values are reported as added or removed. Map values are
+// matched by keys. A nil slice or map is different from an empty one.
+//
+// Unexported struct fields and fields with the "-" name in the "cmp" tag are
+// not compared. Structs without exported fields, for example,
+// [math/big.Int] or [net/netip.Addr], are compared as single values unless
+// [WithUnexported] is used. See [CompareOption] for available options.
+//
+// Changes are returned in the order of struct fields, slice indexes and
+// sorted map keys.
+func Diff(a, b any, opts ...CompareOption) []Change {
+	c := newComparer(newCompareOpts(opts...), false)
+	c.compare(reflect.ValueOf(a), reflect.ValueOf(b), "", "")
+	return c.changes
+}
@@ -0,0 +1,447 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"math/big"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TDiff is a struct used in diff tests.
+type TDiff struct {
+	Name    string            `json:"name"`
+	Age     int               `json:"age"`
+	Tags    []string          `json:"tags"`
+	Attrs   map[string]string `json:"attrs"`
+	Address *TDiffAddress     `json:"address"`
+	Items   []TDiffItem       `json:"items"`
+	Skip    string            `cmp:"-"`
+	When    time.Time         `json:"when"`
+	priv    int
+}
+
+// TDiffAddress is a struct used in diff tests.
+type TDiffAddress struct {
+	City string `json:"city"`
+}
+
+// TDiffItem is a struct used in diff tests.
+type TDiffItem struct {
+	ID    int `json:"id"`
+	Price int `json:"price"`
+}
+
+// TDiffEmbed is a struct used in diff tests.
+type TDiffEmbed struct {
+	TDiffAddress
+	Zip string `json:"zip"`
+}
+
+// TDiffOpaque is a struct with fields of opaque types used in diff tests.
+type TDiffOpaque struct {
+	Num  *big.Int
+	Addr netip.Addr
+}
+
+// TDiffNode is a recursive struct used in diff tests.
+type TDiffNode struct {
+	Val  int
+	Next *TDiffNode
+}
+
+func Test_Change_String(t *testing.T) {
+	t.Run("path", func(t *testing.T) {
+		// --- Given ---
+		ch := Change{Path: "A.B", Old: 1, New: 2}
+
+		// --- When ---
+		have := ch.String()
+
+		// --- Then ---
+		assert.Equal(t, "A.B: 1 -> 2", have)
+	})
+
+	t.Run("root", func(t *testing.T) {
+		// --- Given ---
+		ch := Change{Old: 1, New: nil}
+
+		// --- When ---
+		have := ch.String()
+
+		// --- Then ---
+		assert.Equal(t, "<root>: 1 -> <nil>", have)
+	})
+}
+
+func Test_Diff(t *testing.T) {
+	t.Run("same", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{Name: "a", Tags: []string{"x"}, Address: &TDiffAddress{}}
+		b := TDiff{Name: "a", Tags: []string{"x"}, Address: &TDiffAddress{}}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("scalars", func(t *testing.T) {
+		// --- When ---
+		have := Diff(1, 2)
+
+		// --- Then ---
+		assert.Equal(t, []Change{{Old: 1, New: 2}}, have)
+	})
+
+	t.Run("both nil", func(t *testing.T) {
+		// --- When ---
+		have := Diff(nil, nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		// --- When ---
+		have := Diff(1, "a")
+
+		// --- Then ---
+		assert.Equal(t, []Change{{Old: 1, New: "a"}}, have)
+	})
+
+	t.Run("struct fields", func(t *testing.T) {
+		// --- Given ---
+		a := &TDiff{Name: "a", Age: 1}
+		b := &TDiff{Name: "b", Age: 1}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		want := []Change{{Path: "Name", TagPath: "name", Old: "a", New: "b"}}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("nested struct", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{Address: &TDiffAddress{City: "A"}}
+		b := TDiff{Address: &TDiffAddress{City: "B"}}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		want := []Change{
+			{Path: "Address.City", TagPath: "address.city", Old: "A", New: "B"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		// --- Given ---
+		addr := &TDiffAddress{City: "A"}
+		a := TDiff{}
+		b := TDiff{Address: addr}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		assert.Len(t, 1, have)
+		assert.Equal(t, "Address", have[0].Path)
+		assert.Nil(t, have[0].Old)
+		assert.Same(t, addr, have[0].New)
+	})
+
+	t.Run("slice by index", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{Tags: []string{"a", "b", "c"}}
+		b := TDiff{Tags: []string{"a", "x"}}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		want := []Change{
+			{Path: "Tags[1]", TagPath: "tags[1]", Old: "b", New: "x"},
+			{Path: "Tags[2]", TagPath: "tags[2]", Old: "c", New: nil},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("nil and empty slice are different", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{Tags: nil}
+		b := TDiff{Tags: []string{}}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		assert.Len(t, 1, have)
+		assert.Equal(t, "Tags", have[0].Path)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{Attrs: map[string]string{"a": "1", "b": "2"}}
+		b := TDiff{Attrs: map[string]string{"b": "3", "c": "4"}}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		want := []Change{
+			{Path: "Attrs[a]", TagPath: "attrs[a]", Old: "1", New: nil},
+			{Path: "Attrs[b]", TagPath: "attrs[b]", Old: "2", New: "3"},
+			{Path: "Attrs[c]", TagPath: "attrs[c]", Old: nil, New: "4"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("slice by key", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{Items: []TDiffItem{{1, 10}, {2, 20}, {3, 30}}}
+		b := TDiff{Items: []TDiffItem{{3, 30}, {4, 40}, {1, 11}}}
+
+		// --- When ---
+		have := Diff(a, b, WithSliceKey("Items", "ID"))
+
+		// --- Then ---
+		want := []Change{
+			{
+				Path:    "Items[1].Price",
+				TagPath: "items[1].price",
+				Old:     10,
+				New:     11,
+			},
+			{Path: "Items[2]", TagPath: "items[2]", Old: TDiffItem{2, 20}},
+			{Path: "Items[4]", TagPath: "items[4]", New: TDiffItem{4, 40}},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("slice by key with pointers", func(t *testing.T) {
+		// --- Given ---
+		a := []*TDiffItem{{1, 10}, {2, 20}}
+		b := []*TDiffItem{{2, 21}, {1, 10}}
+
+		// --- When ---
+		have := Diff(a, b, WithSliceKey("", "ID"))
+
+		// --- Then ---
+		want := []Change{
+			{Path: "[2].Price", TagPath: "[2].price", Old: 20, New: 21},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("slice by key falls back to index", func(t *testing.T) {
+		// --- Given ---
+		a := []*TDiffItem{{1, 10}, nil}
+		b := []*TDiffItem{{1, 11}, nil}
+
+		// --- When ---
+		have := Diff(a, b, WithSliceKey("", "ID"))
+
+		// --- Then ---
+		want := []Change{
+			{Path: "[0].Price", TagPath: "[0].price", Old: 10, New: 11},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("ignored by tag", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{Skip: "a"}
+		b := TDiff{Skip: "b"}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("custom ignore tag", func(t *testing.T) {
+		// --- Given ---
+		a := struct {
+			A int `diff:"-"`
+			B int `cmp:"-"`
+		}{1, 1}
+		b := struct {
+			A int `diff:"-"`
+			B int `cmp:"-"`
+		}{2, 2}
+
+		// --- When ---
+		have := Diff(a, b, WithCompareTag("diff"))
+
+		// --- Then ---
+		want := []Change{
+			{Path: "B", TagPath: "B", Old: 1, New: 2},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("ignored by path", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{Name: "a", Items: []TDiffItem{{1, 10}, {2, 20}}}
+		b := TDiff{Name: "b", Items: []TDiffItem{{1, 11}, {3, 21}}}
+
+		// --- When ---
+		have := Diff(a, b, WithIgnorePath("Name", "Items[*].Price"))
+
+		// --- Then ---
+		want := []Change{
+			{Path: "Items[1].ID", TagPath: "items[1].id", Old: 2, New: 3},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("unexported fields skipped by default", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{priv: 1}
+		b := TDiff{priv: 2}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("unexported fields", func(t *testing.T) {
+		// --- Given ---
+		a := TDiff{priv: 1}
+		b := TDiff{priv: 2}
+
+		// --- When ---
+		have := Diff(a, b, WithUnexported())
+
+		// --- Then ---
+		want := []Change{{Path: "priv", TagPath: "priv", Old: 1, New: 2}}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("unexported fields in map values", func(t *testing.T) {
+		// --- Given ---
+		a := map[string]TDiff{"a": {priv: 1}}
+		b := map[string]TDiff{"a": {priv: 2}}
+
+		// --- When ---
+		have := Diff(a, b, WithUnexported())
+
+		// --- Then ---
+		want := []Change{
+			{Path: "[a].priv", TagPath: "[a].priv", Old: 1, New: 2},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("equal method", func(t *testing.T) {
+		// --- Given ---
+		now := time.Now()
+		a := TDiff{When: now}
+		b := TDiff{When: now.In(time.FixedZone("X", 3600))}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("struct without exported fields", func(t *testing.T) {
+		// --- Given ---
+		a := TDiffOpaque{
+			Num:  big.NewInt(1),
+			Addr: netip.MustParseAddr("1.1.1.1"),
+		}
+		b := TDiffOpaque{
+			Num:  big.NewInt(100),
+			Addr: netip.MustParseAddr("2.2.2.2"),
+		}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		assert.Len(t, 2, have)
+		assert.Equal(t, "Num", have[0].Path)
+		assert.Equal(t, "Addr", have[1].Path)
+		assert.Equal(t, netip.MustParseAddr("1.1.1.1"), have[1].Old)
+		assert.Equal(t, netip.MustParseAddr("2.2.2.2"), have[1].New)
+	})
+
+	t.Run("equal structs without exported fields", func(t *testing.T) {
+		// --- Given ---
+		a := TDiffOpaque{
+			Num:  big.NewInt(1),
+			Addr: netip.MustParseAddr("1.1.1.1"),
+		}
+		b := TDiffOpaque{
+			Num:  big.NewInt(1),
+			Addr: netip.MustParseAddr("1.1.1.1"),
+		}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("embedded struct", func(t *testing.T) {
+		// --- Given ---
+		a := TDiffEmbed{TDiffAddress: TDiffAddress{City: "A"}}
+		b := TDiffEmbed{TDiffAddress: TDiffAddress{City: "B"}}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		want := []Change{
+			{Path: "TDiffAddress.City", TagPath: "city", Old: "A", New: "B"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("naming policy", func(t *testing.T) {
+		// --- Given ---
+		a := TDiffNode{Val: 1}
+		b := TDiffNode{Val: 2}
+
+		// --- When ---
+		have := Diff(a, b, WithCompareNaming(NamingPolicy{Fallback: SnakeCase}))
+
+		// --- Then ---
+		want := []Change{
+			{Path: "Val", TagPath: "val", Old: 1, New: 2},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		a := &TDiffNode{Val: 1}
+		a.Next = &TDiffNode{Val: 2, Next: a}
+		b := &TDiffNode{Val: 1}
+		b.Next = &TDiffNode{Val: 3, Next: b}
+
+		// --- When ---
+		have := Diff(a, b)
+
+		// --- Then ---
+		want := []Change{
+			{Path: "Next.Val", TagPath: "Next.Val", Old: 2, New: 3},
+		}
+		assert.Equal(t, want, have)
+	})
+}
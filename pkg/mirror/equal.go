@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+)
+
+// Equal returns true when values "a" and "b" are deeply equal. It uses the
+// same rules as [Diff] but stops at the first difference.
+//
+// Unlike [reflect.DeepEqual], it skips unexported fields (structs without
+// exported fields are compared as single values) and fields with the "-"
+// name in the "cmp" tag, uses the "Equal(T) bool" methods (for example,
+// [time.Time.Equal]) and may be configured with [CompareOption] to ignore
+// paths, compare types with custom functions, compare floating point numbers
+// with tolerance, or treat nil and empty slices and maps as equal:
+//
+//	Equal(a, b,
+//		WithIgnorePath("UpdatedAt"),
+//		WithFloatEpsilon(1e-9),
+//		WithNilEmptyEqual(),
+//	)
+func Equal(a, b any, opts ...CompareOption) bool {
+	c := newComparer(newCompareOpts(opts...), true)
+	c.compare(reflect.ValueOf(a), reflect.ValueOf(b), "", "")
+	return len(c.changes) == 0
+}
@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TEqual is a struct used in equality tests.
+type TEqual struct {
+	Name  string
+	Score float64
+	Tags  []string
+	Attrs map[string]int
+	Any   any
+	Skip  int `cmp:"-"`
+	When  time.Time
+	priv  int
+}
+
+func Test_Equal(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		a := &TEqual{Name: "a", Tags: []string{"x"}, Any: 1}
+		b := &TEqual{Name: "a", Tags: []string{"x"}, Any: 1}
+
+		// --- When ---
+		have := Equal(a, b)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		a := &TEqual{Name: "a"}
+		b := &TEqual{Name: "b"}
+
+		// --- When ---
+		have := Equal(a, b)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		// --- When ---
+		have := Equal(1, int64(1))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("both nil", func(t *testing.T) {
+		// --- When ---
+		have := Equal(nil, nil)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("ignored by tag", func(t *testing.T) {
+		// --- Given ---
+		a := TEqual{Skip: 1}
+		b := TEqual{Skip: 2}
+
+		// --- When ---
+		have := Equal(a, b)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("ignored by path", func(t *testing.T) {
+		// --- Given ---
+		a := TEqual{Name: "a"}
+		b := TEqual{Name: "b"}
+
+		// --- When ---
+		have := Equal(a, b, WithIgnorePath("Name"))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("unexported fields", func(t *testing.T) {
+		// --- Given ---
+		a := TEqual{priv: 1}
+		b := TEqual{priv: 2}
+
+		// --- Then ---
+		assert.True(t, Equal(a, b))
+		assert.False(t, Equal(a, b, WithUnexported()))
+	})
+
+	t.Run("time", func(t *testing.T) {
+		// --- Given ---
+		now := time.Now()
+		a := TEqual{When: now}
+		b := TEqual{When: now.UTC()}
+
+		// --- When ---
+		have := Equal(a, b)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("float epsilon", func(t *testing.T) {
+		// --- Given ---
+		x, y := 0.1, 0.2
+		a := TEqual{Score: x + y}
+		b := TEqual{Score: 0.3}
+
+		// --- Then ---
+		assert.False(t, Equal(a, b))
+		assert.True(t, Equal(a, b, WithFloatEpsilon(1e-9)))
+		assert.False(t, Equal(a, TEqual{Score: 0.4}, WithFloatEpsilon(1e-9)))
+	})
+
+	t.Run("float32 epsilon", func(t *testing.T) {
+		// --- When ---
+		have := Equal(float32(1.0), float32(1.05), WithFloatEpsilon(0.1))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("NaN is not equal", func(t *testing.T) {
+		// --- Given ---
+		nan := TEqual{Any: 0.0}
+		nan.Score = nan.Any.(float64) / nan.Any.(float64)
+
+		// --- When ---
+		have := Equal(nan, nan, WithFloatEpsilon(1))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("nil and empty", func(t *testing.T) {
+		// --- Given ---
+		a := TEqual{Tags: nil, Attrs: nil}
+		b := TEqual{Tags: []string{}, Attrs: map[string]int{}}
+
+		// --- Then ---
+		assert.False(t, Equal(a, b))
+		assert.True(t, Equal(a, b, WithNilEmptyEqual()))
+		assert.True(t, Equal(b, a, WithNilEmptyEqual()))
+	})
+
+	t.Run("nil and not empty", func(t *testing.T) {
+		// --- Given ---
+		a := TEqual{Tags: nil}
+		b := TEqual{Tags: []string{"a"}}
+
+		// --- When ---
+		have := Equal(a, b, WithNilEmptyEqual())
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("type function", func(t *testing.T) {
+		// --- Given ---
+		a := TEqual{Name: "ABC"}
+		b := TEqual{Name: "abc"}
+		opt := WithTypeEqual(strings.EqualFold)
+
+		// --- Then ---
+		assert.False(t, Equal(a, b))
+		assert.True(t, Equal(a, b, opt))
+	})
+
+	t.Run("type function takes precedence over method", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC)
+		a := TEqual{When: tim}
+		b := TEqual{When: tim.Add(time.Second)}
+		opt := WithTypeEqual(func(a, b time.Time) bool {
+			return a.Truncate(time.Minute).Equal(b.Truncate(time.Minute))
+		})
+
+		// --- Then ---
+		assert.False(t, Equal(a, b))
+		assert.True(t, Equal(a, b, opt))
+	})
+
+	t.Run("type function for interface with nil", func(t *testing.T) {
+		// --- Given ---
+		a := TEqual{Any: nil}
+		b := TEqual{Any: 1}
+		opt := WithTypeEqual(func(a, b any) bool { return true })
+
+		// --- When ---
+		have := Equal(a, b, opt)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("struct without exported fields", func(t *testing.T) {
+		// --- When ---
+		have := Equal(big.NewInt(1), big.NewInt(2))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("equal structs without exported fields", func(t *testing.T) {
+		// --- When ---
+		have := Equal(big.NewInt(1), big.NewInt(1))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("cyclic maps", func(t *testing.T) {
+		// --- Given ---
+		a := map[string]any{"v": 1}
+		a["x"] = a
+		b := map[string]any{"v": 1}
+		b["x"] = b
+
+		// --- When ---
+		have := Equal(a, b)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("cyclic maps not equal", func(t *testing.T) {
+		// --- Given ---
+		a := map[string]any{"v": 1}
+		a["x"] = a
+		b := map[string]any{"v": 2}
+		b["x"] = b
+
+		// --- When ---
+		have := Equal(a, b)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("cyclic slices", func(t *testing.T) {
+		// --- Given ---
+		a := []any{nil, 1}
+		a[0] = a
+		b := []any{nil, 1}
+		b[0] = b
+
+		// --- When ---
+		have := Equal(a, b)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+}
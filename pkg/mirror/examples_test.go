@@ -4,6 +4,7 @@
 package mirror_test
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"time"
@@ -135,3 +136,26 @@ func ExampleFieldValue_Get() {
 	// Output:
 	// F1 value: 42
 }
+
+func ExampleValidate() {
+	s := &struct {
+		Name string   `json:"name" validate:"required,min=2"`
+		Age  int      `json:"age" validate:"min=18,max=99"`
+		Tags []string `json:"tags" validate:"unique,dive,oneof=a b c"`
+	}{
+		Name: "Bob",
+		Age:  10,
+		Tags: []string{"a", "x"},
+	}
+
+	err := mirror.Validate(s)
+
+	var es mirror.ValidationErrors
+	errors.As(err, &es)
+	for _, e := range es {
+		fmt.Printf("%s (%s): %s\n", e.Path, e.TagPath, e.Rule)
+	}
+	// Output:
+	// Age (age): min
+	// Tags[1] (tags[1]): oneof
+}
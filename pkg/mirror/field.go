@@ -5,6 +5,7 @@ package mirror
 
 import (
 	"reflect"
+	"sync"
 )
 
 // Field represents struct field.
@@ -19,13 +20,21 @@ type Field struct {
 	sliceOrArr bool                // Is slice or array?
 	index      []int               // Index sequence for [reflect.Type.FieldByIndex].
 	tags       []Tag               // Additional tag options.
+	tagErr     error               // Tag syntax error.
+	names      sync.Map            // Names derived by naming strategies.
 }
 
 // NewField returns new instance of struct field.
 func NewField(sf reflect.StructField) *Field {
+	return newField(sf, make(map[reflect.Type]*Metadata))
+}
+
+// newField returns new instance of struct field. See [newTypeMetadata] for
+// the "seen" argument description.
+func newField(sf reflect.StructField, seen map[reflect.Type]*Metadata) *Field {
 	kind := sf.Type.Kind()
 	fld := &Field{
-		metadata:   NewTypeMetadata(sf.Type),
+		metadata:   newTypeMetadata(sf.Type, seen),
 		sf:         sf,
 		typ:        sf.Type,
 		kind:       kind,
@@ -34,7 +43,7 @@ func NewField(sf reflect.StructField) *Field {
 		sliceOrArr: kind == reflect.Slice || kind == reflect.Array,
 		index:      sf.Index,
 	}
-	fld.tags, _ = ParseTags(fld.sf.Name, string(fld.sf.Tag))
+	fld.tags, fld.tagErr = ParseTags(fld.sf.Name, string(fld.sf.Tag))
 	if fld.sliceOrArr && sf.Type.Elem().Kind() == reflect.Ptr {
 		fld.sliceOfPtr = true
 	}
@@ -56,6 +65,23 @@ func (fld *Field) Index() []int { return fld.index }
 // Name returns the name of the struct field.
 func (fld *Field) Name() string { return fld.sf.Name }
 
+// NameWith returns the field name derived using the naming strategy. When
+// the strategy is nil, it returns the Go field name. Derived names are cached
+// for strategies of comparable types.
+func (fld *Field) NameWith(ns NamingStrategy) string {
+	if ns == nil {
+		return fld.sf.Name
+	}
+	if !reflect.TypeOf(ns).Comparable() {
+		return ns.FieldName(fld.sf.Name)
+	}
+	if name, ok := fld.names.Load(ns); ok {
+		return name.(string)
+	}
+	name, _ := fld.names.LoadOrStore(ns, ns.FieldName(fld.sf.Name))
+	return name.(string)
+}
+
 // Tag returns tag by name, if the tag doesn't exist, it returns a tag for
 // which the [Tag.IsZero] method returns true.
 func (fld *Field) Tag(key string) Tag {
@@ -67,6 +93,29 @@ func (fld *Field) Tag(key string) Tag {
 	return Tag{field: fld.sf.Name}
 }
 
+// TagAny returns the first existing tag for the given keys. If none of the
+// tags exist, it returns a tag for which the [Tag.IsZero] method returns true.
+func (fld *Field) TagAny(keys ...string) Tag {
+	for _, key := range keys {
+		if tag := fld.Tag(key); !tag.IsZero() {
+			return tag
+		}
+	}
+	return Tag{field: fld.sf.Name}
+}
+
+// Tags returns a copy of all field tags in the order they were defined.
+func (fld *Field) Tags() Tags {
+	if fld.tags == nil {
+		return nil
+	}
+	return append(Tags(nil), fld.tags...)
+}
+
+// TagError returns [TagSyntaxError] when the field tag is malformed, nil
+// otherwise. Fields with malformed tags have no tags.
+func (fld *Field) TagError() error { return fld.tagErr }
+
 // IsValid returns true for fields that may appear in names to struct fields.
 func (fld *Field) IsValid() bool { return !fld.IsInterface() && !fld.anonymous }
 
@@ -103,8 +152,31 @@ func (fld *Field) IsMap() bool { return fld.kind == reflect.Map }
 // IsInterface returns true if the field is an interface, false otherwise.
 func (fld *Field) IsInterface() bool { return fld.kind == reflect.Interface }
 
+// IsNilable returns true if the field can be nil. Unlike the metadata of
+// the field type, it reports pointer fields as nilable.
+func (fld *Field) IsNilable() bool { return isNilableKind(fld.kind) }
+
 // TypeMetadata return [Metadata] for the type.
 func (fld *Field) TypeMetadata() *Metadata { return fld.metadata }
 
+// ElemMetadata returns metadata of the field element type for pointer, slice,
+// array, map and channel fields. Returns nil for other kinds. For a pointer
+// field, it returns the metadata of the type it points to.
+func (fld *Field) ElemMetadata() *Metadata {
+	if fld.kind == reflect.Ptr {
+		return fld.metadata
+	}
+	return fld.metadata.Elem()
+}
+
+// KeyMetadata returns metadata of the map field key type. Returns nil for
+// other kinds.
+func (fld *Field) KeyMetadata() *Metadata {
+	if fld.kind != reflect.Map {
+		return nil
+	}
+	return fld.metadata.Key()
+}
+
 // IsAnonymous returns true for embedded fields, false otherwise.
 func (fld *Field) IsAnonymous() bool { return fld.anonymous }
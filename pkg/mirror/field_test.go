@@ -116,6 +116,7 @@ func Test_Field_Tag(t *testing.T) {
 		want := Tag{
 			field:   "F",
 			key:     "tag",
+			raw:     "t1,t2, t3",
 			name:    "t1",
 			options: []string{"t2", "t3"},
 		}
@@ -138,6 +139,186 @@ func Test_Field_Tag(t *testing.T) {
 	})
 }
 
+func Test_Field_NameWith(t *testing.T) {
+	t.Run("strategy", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ HTTPServerURL string }{}
+		fld := NewField(reflectkit.GetField(t, s, "HTTPServerURL"))
+
+		// --- When ---
+		have := fld.NameWith(SnakeCase)
+
+		// --- Then ---
+		assert.Equal(t, "http_server_url", have)
+	})
+
+	t.Run("nil strategy", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ HTTPServerURL string }{}
+		fld := NewField(reflectkit.GetField(t, s, "HTTPServerURL"))
+
+		// --- When ---
+		have := fld.NameWith(nil)
+
+		// --- Then ---
+		assert.Equal(t, "HTTPServerURL", have)
+	})
+
+	t.Run("cached per strategy", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ UserName string }{}
+		fld := NewField(reflectkit.GetField(t, s, "UserName"))
+
+		// --- When ---
+		snake := fld.NameWith(SnakeCase)
+		kebab := fld.NameWith(KebabCase)
+
+		// --- Then ---
+		assert.Equal(t, "user_name", snake)
+		assert.Equal(t, "user-name", kebab)
+		have, _ := fld.names.Load(SnakeCase)
+		assert.Equal(t, "user_name", have)
+		have, _ = fld.names.Load(KebabCase)
+		assert.Equal(t, "user-name", have)
+	})
+
+	t.Run("function strategy is not cached", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ UserName string }{}
+		fld := NewField(reflectkit.GetField(t, s, "UserName"))
+		var cnt int
+		fn := NamingFunc(func(name string) string { cnt++; return name })
+
+		// --- When ---
+		fld.NameWith(fn)
+		have := fld.NameWith(fn)
+
+		// --- Then ---
+		assert.Equal(t, "UserName", have)
+		assert.Equal(t, 2, cnt)
+	})
+}
+
+func Test_Field_TagAny(t *testing.T) {
+	t.Run("first key", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F string `json:"j" yaml:"y"`
+		}{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.TagAny("yaml", "json")
+
+		// --- Then ---
+		assert.Equal(t, "yaml", have.Key())
+		assert.Equal(t, "y", have.Name())
+	})
+
+	t.Run("fallback key", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F string `json:"j"`
+		}{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.TagAny("mapstructure", "yaml", "json")
+
+		// --- Then ---
+		assert.Equal(t, "json", have.Key())
+		assert.Equal(t, "j", have.Name())
+	})
+
+	t.Run("ignored tag is returned", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F string `json:"j" yaml:"-"`
+		}{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.TagAny("yaml", "json")
+
+		// --- Then ---
+		assert.Equal(t, "yaml", have.Key())
+		assert.True(t, have.IsIgnored())
+	})
+
+	t.Run("none exist", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F string }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.TagAny("yaml", "json")
+
+		// --- Then ---
+		assert.True(t, have.IsZero())
+		assert.Equal(t, "F", have.NameOrField())
+	})
+}
+
+func Test_Field_Tags(t *testing.T) {
+	t.Run("tags", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F string `json:"f" db:"id"`
+		}{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.Tags()
+
+		// --- Then ---
+		assert.Equal(t, `json:"f" db:"id"`, have.String())
+		have.Delete("json")
+		assert.Len(t, 2, fld.tags)
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F string }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.Tags()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Field_TagError(t *testing.T) {
+	t.Run("valid tag", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F string `json:"f"`
+		}{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		err := fld.TagError()
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("malformed tag", func(t *testing.T) {
+		// --- Given ---
+		fld := NewField(malformedTagType().Field(1))
+
+		// --- When ---
+		err := fld.TagError()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagSyntax, err)
+		wMsg := `struct field tag syntax error: field F1 at offset 0: "json:f1"`
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, fld.tags)
+	})
+}
+
 func Test_Field_IsValid(t *testing.T) {
 	t.Run("is valid", func(t *testing.T) {
 		// --- Given ---
@@ -525,6 +706,33 @@ func Test_Field_IsExported(t *testing.T) {
 	})
 }
 
+func Test_Field_IsNilable(t *testing.T) {
+	t.Run("pointer", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F *int }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.IsNilable()
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.False(t, fld.TypeMetadata().IsNilable())
+	})
+
+	t.Run("not nilable", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F int }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.IsNilable()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
 func Test_Field_TypeMetadata(t *testing.T) {
 	t.Run("metadata", func(t *testing.T) {
 		// --- Given ---
@@ -540,6 +748,82 @@ func Test_Field_TypeMetadata(t *testing.T) {
 	})
 }
 
+func Test_Field_ElemMetadata(t *testing.T) {
+	t.Run("slice of pointers", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F []*TStruct }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.ElemMetadata()
+
+		// --- Then ---
+		assert.Equal(t, "TStruct", have.Name())
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F *TStruct }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.ElemMetadata()
+
+		// --- Then ---
+		assert.Same(t, fld.TypeMetadata(), have)
+	})
+
+	t.Run("pointer to slice", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F *[]int }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.ElemMetadata()
+
+		// --- Then ---
+		assert.Equal(t, reflect.Slice, have.Kind())
+	})
+
+	t.Run("no element", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F int }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.ElemMetadata()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Field_KeyMetadata(t *testing.T) {
+	t.Run("map", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F map[string]TStruct }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.KeyMetadata()
+
+		// --- Then ---
+		assert.Equal(t, reflect.String, have.Kind())
+	})
+
+	t.Run("pointer to map", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F *map[string]int }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have := fld.KeyMetadata()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
 func Test_Field_IsAnonymous(t *testing.T) {
 	t.Run("not anonymous", func(t *testing.T) {
 		// --- Given ---
@@ -14,11 +14,12 @@ type field = Field // Do not expose the embedded struct.
 type FieldValue struct {
 	*field
 	value reflect.Value
+	path  string // Path to the field using Go field names.
 }
 
 // NewFieldValue returns a new instance of [FieldValue].
 func NewFieldValue(fld *Field, value reflect.Value) *FieldValue {
-	return &FieldValue{field: fld, value: value}
+	return &FieldValue{field: fld, value: value, path: fld.Name()}
 }
 
 // StructValue returns the field as [StructValue].
@@ -27,18 +28,28 @@ func (fv *FieldValue) StructValue() *StructValue {
 		metadata: ReflectType(fv.Type()),
 		value:    fv.value,
 		kind:     fv.kind,
+		path:     fv.path,
 	}
 }
 
 // Field returns the [Field] associated with the [FieldValue].
 func (fv *FieldValue) Field() *Field { return fv.field }
 
+// Path returns the path to the field using Go field names. The path is
+// relative to the root [StructValue].
+func (fv *FieldValue) Path() string { return fv.path }
+
 // Value returns the [reflect.Value] associated with the [FieldValue].
 func (fv *FieldValue) Value() reflect.Value { return fv.value }
 
 // NewIfNil initializes the value of a field if it is nil with its zero value.
+// It does nothing when the field is not settable, for example, the field of
+// a read-only [StructValue].
 func (fv *FieldValue) NewIfNil() *FieldValue {
 	v := fv.value
+	if !v.CanSet() {
+		return fv
+	}
 	switch fv.kind {
 	case reflect.Ptr:
 		if v.IsNil() {
@@ -75,3 +86,78 @@ func (fv *FieldValue) Get() (any, error) {
 	}
 	return fv.value.Interface(), nil
 }
+
+// SetOption represents [FieldValue.Set] option.
+type SetOption func(*setOpts)
+
+// WithProvenance records the source name and the raw input the value was
+// created from in the [Provenance] recorder under the field path.
+func WithProvenance(p *Provenance, source, raw string) SetOption {
+	return func(opts *setOpts) {
+		opts.prov = p
+		opts.source = source
+		opts.raw = raw
+	}
+}
+
+// setOpts represents [FieldValue.Set] options.
+type setOpts struct {
+	prov   *Provenance // Provenance recorder.
+	source string      // Source name.
+	raw    string      // Raw input.
+}
+
+// Set sets the field value. The value must be assignable to the field type
+// or be convertible to it and have the same kind. The nil value sets the
+// field to its zero value. Interface and embedded fields may be set too.
+//
+// It returns an error if the field value is invalid, the field is
+// unexported, not addressable (for example, the field of a read-only
+// [StructValue]), not settable, or the value is of invalid type.
+func (fv *FieldValue) Set(v any, opts ...SetOption) error {
+	if !fv.value.IsValid() {
+		return ErrInvField
+	}
+	if !fv.IsExported() {
+		return fmt.Errorf("%w: %s", ErrUnexportedField, fv.path)
+	}
+	if !fv.value.CanAddr() {
+		return fmt.Errorf("%w: %s", ErrNotAddressable, fv.path)
+	}
+	if !fv.value.CanSet() {
+		return fmt.Errorf("%w: %s: not settable", ErrInvField, fv.path)
+	}
+
+	return fv.set(fv.value, v, opts)
+}
+
+// set sets the destination value of the field. See [FieldValue.Set] for
+// details.
+func (fv *FieldValue) set(dst reflect.Value, v any, opts []SetOption) error {
+	val := reflect.ValueOf(v)
+	switch {
+	case !val.IsValid():
+		dst.SetZero()
+	case val.Type().AssignableTo(fv.typ):
+		dst.Set(val)
+	case val.Kind() == fv.kind && val.Type().ConvertibleTo(fv.typ):
+		dst.Set(val.Convert(fv.typ))
+	default:
+		return fmt.Errorf(
+			"%w: %s: cannot use %T as %s",
+			ErrFieldType,
+			fv.path,
+			v,
+			fv.typ,
+		)
+	}
+
+	ops := &setOpts{}
+	for _, opt := range opts {
+		opt(ops)
+	}
+	if ops.prov != nil {
+		ops.prov.Record(fv.path, ops.source, ops.raw)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ package mirror
 
 import (
 	"io"
+	"reflect"
 	"testing"
 
 	"github.com/ctx42/testing/pkg/assert"
@@ -80,6 +81,34 @@ func Test_FieldValue_Value(t *testing.T) {
 	assert.Equal(t, val, have)
 }
 
+func Test_FieldValue_Path(t *testing.T) {
+	t.Run("constructor", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F string }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+		val := reflectkit.GetValue(t, s, "F")
+		fv := NewFieldValue(fld, val)
+
+		// --- When ---
+		have := fv.Path()
+
+		// --- Then ---
+		assert.Equal(t, "F", have)
+	})
+
+	t.Run("nested field", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F *TStruct }{}
+		fv := NewStructValue(s).FieldByName("F").NewIfNil()
+
+		// --- When ---
+		have := fv.StructValue().FieldByName("FStr").Path()
+
+		// --- Then ---
+		assert.Equal(t, "F.FStr", have)
+	})
+}
+
 func Test_FieldValue_StructValue(t *testing.T) {
 	t.Run("field", func(t *testing.T) {
 		// --- Given ---
@@ -93,6 +122,7 @@ func Test_FieldValue_StructValue(t *testing.T) {
 
 		// --- Then ---
 		assert.True(t, have.IsValid())
+		assert.Equal(t, "F", have.Path())
 		have.FieldByName("FStr").Value().SetString("b")
 		assert.Equal(t, "b", s.F.FStr)
 	})
@@ -190,6 +220,26 @@ func Test_FieldValue_NewIfNil(t *testing.T) {
 		assert.Same(t, fv, have)
 	})
 
+	t.Run("read-only struct", func(t *testing.T) {
+		// --- Given ---
+		s := struct {
+			P *TStruct
+			M map[int]string
+			S []string
+		}{}
+		sv, _ := NewStructValueOf(s)
+
+		// --- When ---
+		haveP := sv.FieldByName("P").NewIfNil()
+		haveM := sv.FieldByName("M").NewIfNil()
+		haveS := sv.FieldByName("S").NewIfNil()
+
+		// --- Then ---
+		assert.True(t, haveP.Value().IsNil())
+		assert.True(t, haveM.Value().IsNil())
+		assert.True(t, haveS.Value().IsNil())
+	})
+
 	t.Run("pointer to build in type", func(t *testing.T) {
 		// --- Given ---
 		s := &struct{ F *float64 }{}
@@ -282,3 +332,214 @@ func Test_FieldValue_Get(t *testing.T) {
 		assert.Nil(t, have)
 	})
 }
+
+func Test_FieldValue_Set(t *testing.T) {
+	t.Run("assignable", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F string }{}
+		fv := NewStructValue(s).FieldByName("F")
+
+		// --- When ---
+		err := fv.Set("abc")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", s.F)
+	})
+
+	t.Run("interface field", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F any }{}
+		fv := NewStructValue(s).FieldByName("F")
+
+		// --- When ---
+		err := fv.Set("abc")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", s.F)
+	})
+
+	t.Run("embedded struct field", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ TStruct }{}
+		fv := NewStructValue(s).FieldByName("TStruct")
+
+		// --- When ---
+		err := fv.Set(TStruct{FStr: "abc"})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", s.FStr)
+	})
+
+	t.Run("convertible", func(t *testing.T) {
+		// --- Given ---
+		type Str string
+		s := &struct{ F Str }{}
+		fv := NewStructValue(s).FieldByName("F")
+
+		// --- When ---
+		err := fv.Set("abc")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, Str("abc"), s.F)
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F *int }{}
+		fv := NewStructValue(s).FieldByName("F")
+
+		// --- When ---
+		err := fv.Set(ptr(42))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 42, *s.F)
+	})
+
+	t.Run("nil sets zero value", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F *int }{F: ptr(42)}
+		fv := NewStructValue(s).FieldByName("F")
+
+		// --- When ---
+		err := fv.Set(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, s.F)
+	})
+
+	t.Run("with provenance", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F *TStruct }{}
+		fv := NewStructValue(s).FieldByName("F").NewIfNil()
+		prov := NewProvenance()
+
+		// --- When ---
+		err := fv.StructValue().FieldByName("FStr").
+			Set("abc", WithProvenance(prov, "env", "APP_STR=abc"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", s.F.FStr)
+		have, ok := prov.Lookup("F.FStr")
+		assert.True(t, ok)
+		want := Origin{Path: "F.FStr", Source: "env", Raw: "APP_STR=abc"}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F int }{F: 1}
+		fv := NewStructValue(s).FieldByName("F")
+		prov := NewProvenance()
+
+		// --- When ---
+		err := fv.Set("abc", WithProvenance(prov, "env", "abc"))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrFieldType, err)
+		wMsg := "invalid field value type: F: cannot use string as int"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Equal(t, 1, s.F)
+		assert.Nil(t, prov.Origins())
+	})
+
+	t.Run("error - different kind", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F string }{}
+		fv := NewStructValue(s).FieldByName("F")
+
+		// --- When ---
+		err := fv.Set(65)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrFieldType, err)
+		assert.Equal(t, "", s.F)
+	})
+
+	t.Run("error - integer to float", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ F float64 }{}
+		fv := NewStructValue(s).FieldByName("F")
+
+		// --- When ---
+		err := fv.Set(1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrFieldType, err)
+		assert.Equal(t, 0.0, s.F)
+	})
+
+	t.Run("error - unexported field", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ f string }{}
+		fv := NewStructValue(s).FieldByName("f")
+
+		// --- When ---
+		err := fv.Set("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnexportedField, err)
+		assert.ErrorEqual(t, "unexported field: f", err)
+	})
+
+	t.Run("error - not addressable", func(t *testing.T) {
+		// --- Given ---
+		s := struct{ F string }{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+		fv := NewFieldValue(fld, reflect.ValueOf(s).Field(0))
+
+		// --- When ---
+		err := fv.Set("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotAddressable, err)
+		assert.ErrorEqual(t, "value not addressable: F", err)
+	})
+
+	t.Run("error - invalid value", func(t *testing.T) {
+		// --- Given ---
+		fld := Reflect(TStruct{}).FieldByName("FStr")
+		fv := NewFieldValue(fld, reflect.Value{})
+
+		// --- When ---
+		err := fv.Set("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvField, err)
+	})
+
+	t.Run("error - read-only struct", func(t *testing.T) {
+		// --- Given ---
+		s := struct{ F string }{}
+		sv, _ := NewStructValueOf(s)
+		fv := sv.FieldByName("F")
+
+		// --- When ---
+		err := fv.Set("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotAddressable, err)
+		assert.ErrorEqual(t, "value not addressable: F", err)
+	})
+
+	t.Run("error - not settable", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ t TwoStr }{}
+		val := reflect.ValueOf(s).Elem().Field(0).Field(0)
+		fld := Reflect(TwoStr{}).FieldByIndex(0)
+		fv := NewFieldValue(fld, val)
+
+		// --- When ---
+		err := fv.Set("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvField, err)
+		assert.ErrorEqual(t, "invalid field: FStr: not settable", err)
+	})
+}
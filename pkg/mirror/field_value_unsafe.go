@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"fmt"
+)
+
+// This file contains the API giving access to unexported struct fields. It
+// bypasses the Go visibility rules using the unsafe package and should be
+// used only by tools like test helpers, debug dumpers or snapshot tooling.
+
+// GetUnexported gets the field value, even if the field is unexported.
+// Unexported fields can be read only when the struct is addressable, for
+// example, when [StructValue] was created from a pointer.
+//
+// It returns an error if the field value is invalid or the field is
+// unexported and not addressable.
+func (fv *FieldValue) GetUnexported() (any, error) {
+	if !fv.value.IsValid() {
+		return nil, ErrInvField
+	}
+	if fv.value.CanInterface() {
+		return fv.value.Interface(), nil
+	}
+	if !fv.value.CanAddr() {
+		return nil, fmt.Errorf("%w: %s", ErrNotAddressable, fv.path)
+	}
+	return exportValue(fv.value).Interface(), nil
+}
+
+// SetUnexported sets the field value, even if the field is unexported. The
+// value is converted using the same rules as [FieldValue.Set]. The struct
+// must be addressable, for example, [StructValue] created from a pointer.
+//
+// It returns an error if the field value is invalid, the field is not
+// addressable, or the value is of invalid type.
+func (fv *FieldValue) SetUnexported(v any, opts ...SetOption) error {
+	if !fv.value.IsValid() {
+		return ErrInvField
+	}
+	if !fv.value.CanAddr() {
+		return fmt.Errorf("%w: %s", ErrNotAddressable, fv.path)
+	}
+	return fv.set(exportValue(fv.value), v, opts)
+}
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TPrivate is a type used in unexported field access tests.
+type TPrivate struct {
+	Pub  string
+	priv int
+	ptr  *TPrivate
+}
+
+func Test_FieldValue_GetUnexported(t *testing.T) {
+	t.Run("unexported", func(t *testing.T) {
+		// --- Given ---
+		s := &TPrivate{priv: 42}
+		fv := NewStructValue(s).FieldByName("priv")
+
+		// --- When ---
+		have, err := fv.GetUnexported()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 42, have)
+	})
+
+	t.Run("unexported pointer", func(t *testing.T) {
+		// --- Given ---
+		p := &TPrivate{}
+		s := &TPrivate{ptr: p}
+		fv := NewStructValue(s).FieldByName("ptr")
+
+		// --- When ---
+		have, err := fv.GetUnexported()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, p, have)
+	})
+
+	t.Run("exported", func(t *testing.T) {
+		// --- Given ---
+		s := &TPrivate{Pub: "abc"}
+		fv := NewStructValue(s).FieldByName("Pub")
+
+		// --- When ---
+		have, err := fv.GetUnexported()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have)
+	})
+
+	t.Run("exported not addressable", func(t *testing.T) {
+		// --- Given ---
+		s := TPrivate{Pub: "abc"}
+		fld := Reflect(s).FieldByName("Pub")
+		fv := NewFieldValue(fld, reflect.ValueOf(s).Field(0))
+
+		// --- When ---
+		have, err := fv.GetUnexported()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have)
+	})
+
+	t.Run("error - not addressable", func(t *testing.T) {
+		// --- Given ---
+		s := TPrivate{priv: 42}
+		fld := Reflect(s).FieldByName("priv")
+		fv := NewFieldValue(fld, reflect.ValueOf(s).Field(1))
+
+		// --- When ---
+		have, err := fv.GetUnexported()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotAddressable, err)
+		assert.ErrorEqual(t, "value not addressable: priv", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("interface field", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ f any }{f: 42}
+		fv := NewStructValue(s).FieldByName("f")
+
+		// --- When ---
+		have, err := fv.GetUnexported()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 42, have)
+	})
+
+	t.Run("error - invalid value", func(t *testing.T) {
+		// --- Given ---
+		fld := Reflect(TPrivate{}).FieldByName("priv")
+		fv := NewFieldValue(fld, reflect.Value{})
+
+		// --- When ---
+		have, err := fv.GetUnexported()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvField, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_FieldValue_SetUnexported(t *testing.T) {
+	t.Run("unexported", func(t *testing.T) {
+		// --- Given ---
+		s := &TPrivate{}
+		fv := NewStructValue(s).FieldByName("priv")
+
+		// --- When ---
+		err := fv.SetUnexported(42)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 42, s.priv)
+	})
+
+	t.Run("converted", func(t *testing.T) {
+		// --- Given ---
+		type Int int
+		s := &TPrivate{}
+		fv := NewStructValue(s).FieldByName("priv")
+
+		// --- When ---
+		err := fv.SetUnexported(Int(42))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 42, s.priv)
+	})
+
+	t.Run("nil sets zero value", func(t *testing.T) {
+		// --- Given ---
+		s := &TPrivate{ptr: &TPrivate{}}
+		fv := NewStructValue(s).FieldByName("ptr")
+
+		// --- When ---
+		err := fv.SetUnexported(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, s.ptr)
+	})
+
+	t.Run("exported", func(t *testing.T) {
+		// --- Given ---
+		s := &TPrivate{}
+		fv := NewStructValue(s).FieldByName("Pub")
+
+		// --- When ---
+		err := fv.SetUnexported("abc")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", s.Pub)
+	})
+
+	t.Run("with provenance", func(t *testing.T) {
+		// --- Given ---
+		s := &TPrivate{}
+		fv := NewStructValue(s).FieldByName("priv")
+		p := NewProvenance()
+
+		// --- When ---
+		err := fv.SetUnexported(42, WithProvenance(p, "test", "42"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have, ok := p.Lookup("priv")
+		assert.True(t, ok)
+		assert.Equal(t, "test", have.Source)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		s := &TPrivate{}
+		fv := NewStructValue(s).FieldByName("priv")
+
+		// --- When ---
+		err := fv.SetUnexported("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrFieldType, err)
+		assert.ErrorEqual(
+			t,
+			"invalid field value type: priv: cannot use string as int",
+			err,
+		)
+		assert.Equal(t, 0, s.priv)
+	})
+
+	t.Run("error - not addressable", func(t *testing.T) {
+		// --- Given ---
+		s := TPrivate{}
+		fld := Reflect(s).FieldByName("priv")
+		fv := NewFieldValue(fld, reflect.ValueOf(s).Field(1))
+
+		// --- When ---
+		err := fv.SetUnexported(42)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotAddressable, err)
+	})
+
+	t.Run("error - read-only struct", func(t *testing.T) {
+		// --- Given ---
+		sv, _ := NewStructValueOf(TPrivate{})
+		fv := sv.FieldByName("priv")
+
+		// --- When ---
+		err := fv.SetUnexported(42)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotAddressable, err)
+	})
+
+	t.Run("interface field", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ f any }{}
+		fv := NewStructValue(s).FieldByName("f")
+
+		// --- When ---
+		err := fv.SetUnexported(1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, s.f)
+	})
+
+	t.Run("error - invalid value", func(t *testing.T) {
+		// --- Given ---
+		fld := Reflect(TPrivate{}).FieldByName("priv")
+		fv := NewFieldValue(fld, reflect.Value{})
+
+		// --- When ---
+		err := fv.SetUnexported(1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvField, err)
+	})
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// errorType is the type of the error interface.
+var errorType = reflect.TypeFor[error]()
+
+// closureRx matches names of closures, for example, "pkg.Fn.func1" or
+// "pkg.Fn.func1.2".
+var closureRx = regexp.MustCompile(`\.func\d+(\.\d+)*$`)
+
+// FuncInfo represents function signature metadata. The source location and
+// the kind of the function are known only when [Metadata] was created from
+// a function value.
+type FuncInfo struct {
+	typ      reflect.Type // Function type.
+	in       []*Metadata  // Parameters metadata.
+	out      []*Metadata  // Results metadata.
+	variadic bool         // Is variadic function.
+	retErr   bool         // The last result is an error.
+
+	file     string // Source file, may be empty.
+	line     int    // Source line, zero when not known.
+	closure  bool   // Is a closure.
+	methodFn bool   // Is a method value.
+}
+
+// newFuncInfo returns [FuncInfo] for the function type. See
+// [newTypeMetadata] for the "seen" argument description.
+func newFuncInfo(typ reflect.Type, seen map[reflect.Type]*Metadata) *FuncInfo {
+	fi := &FuncInfo{typ: typ, variadic: typ.IsVariadic()}
+	if n := typ.NumIn(); n > 0 {
+		fi.in = make([]*Metadata, n)
+		for i := range fi.in {
+			fi.in[i] = newTypeMetadata(typ.In(i), seen)
+		}
+	}
+	if n := typ.NumOut(); n > 0 {
+		fi.out = make([]*Metadata, n)
+		for i := range fi.out {
+			fi.out[i] = newTypeMetadata(typ.Out(i), seen)
+		}
+		fi.retErr = typ.Out(n-1) == errorType
+	}
+	return fi
+}
+
+// setSource sets the source information using the runtime function.
+func (fi *FuncInfo) setSource(fn *runtime.Func) {
+	fi.file, fi.line = fn.FileLine(fn.Entry())
+	name := fn.Name()
+	fi.methodFn = strings.HasSuffix(name, "-fm")
+	fi.closure = !fi.methodFn && closureRx.MatchString(name)
+}
+
+// Type returns the function type.
+func (fi *FuncInfo) Type() reflect.Type { return fi.typ }
+
+// In returns metadata of the function parameters. Metadata describes types
+// after indirection, use [reflect.Type.In] on [FuncInfo.Type] to get exact
+// types. The slice must be considered as read-only.
+func (fi *FuncInfo) In() []*Metadata { return fi.in }
+
+// Out returns metadata of the function results. Metadata describes types
+// after indirection, use [reflect.Type.Out] on [FuncInfo.Type] to get exact
+// types. The slice must be considered as read-only.
+func (fi *FuncInfo) Out() []*Metadata { return fi.out }
+
+// IsVariadic returns true if the function is variadic.
+func (fi *FuncInfo) IsVariadic() bool { return fi.variadic }
+
+// ReturnsError returns true if the last function result is an error.
+func (fi *FuncInfo) ReturnsError() bool { return fi.retErr }
+
+// File returns the source file where the function is defined. Returns empty
+// string when not known.
+func (fi *FuncInfo) File() string { return fi.file }
+
+// Line returns the source line of the function entry point. Returns zero
+// when not known.
+func (fi *FuncInfo) Line() int { return fi.line }
+
+// IsClosure returns true if the function is an anonymous function.
+func (fi *FuncInfo) IsClosure() bool { return fi.closure }
+
+// IsMethodValue returns true if the function is a method bound to
+// a receiver, for example, "buf.String".
+func (fi *FuncInfo) IsMethodValue() bool { return fi.methodFn }
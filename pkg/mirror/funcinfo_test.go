@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TFuncHandler is a function used in function metadata tests.
+func TFuncHandler(name string, ids ...int) (*TStruct, error) {
+	return nil, nil
+}
+
+func Test_newFuncInfo(t *testing.T) {
+	t.Run("params and results", func(t *testing.T) {
+		// --- Given ---
+		typ := reflect.TypeOf(TFuncHandler)
+
+		// --- When ---
+		have := newFuncInfo(typ, map[reflect.Type]*Metadata{})
+
+		// --- Then ---
+		assert.Equal(t, typ, have.Type())
+		assert.Len(t, 2, have.In())
+		assert.Equal(t, reflect.String, have.In()[0].Kind())
+		assert.Equal(t, reflect.Slice, have.In()[1].Kind())
+		assert.Len(t, 2, have.Out())
+		assert.Equal(t, "TStruct", have.Out()[0].Name())
+		assert.True(t, have.Out()[0].IsStruct())
+		assert.Equal(t, reflect.Interface, have.Out()[1].Kind())
+		assert.True(t, have.IsVariadic())
+		assert.True(t, have.ReturnsError())
+		assert.Equal(t, "", have.File())
+		assert.Equal(t, 0, have.Line())
+		assert.False(t, have.IsClosure())
+		assert.False(t, have.IsMethodValue())
+	})
+
+	t.Run("no params and results", func(t *testing.T) {
+		// --- Given ---
+		typ := reflect.TypeOf(func() {})
+
+		// --- When ---
+		have := newFuncInfo(typ, map[reflect.Type]*Metadata{})
+
+		// --- Then ---
+		assert.Nil(t, have.In())
+		assert.Nil(t, have.Out())
+		assert.False(t, have.IsVariadic())
+		assert.False(t, have.ReturnsError())
+	})
+
+	t.Run("error not the last result", func(t *testing.T) {
+		// --- Given ---
+		typ := reflect.TypeOf(func() (error, int) { return nil, 0 })
+
+		// --- When ---
+		have := newFuncInfo(typ, map[reflect.Type]*Metadata{})
+
+		// --- Then ---
+		assert.False(t, have.ReturnsError())
+	})
+
+	t.Run("recursive func type", func(t *testing.T) {
+		// --- Given ---
+		type state func() state
+		typ := reflect.TypeOf(state(nil))
+
+		// --- When ---
+		have := NewTypeMetadata(typ)
+
+		// --- Then ---
+		assert.Same(t, have, have.Func().Out()[0])
+	})
+}
+
+func Test_FuncInfo_source(t *testing.T) {
+	t.Run("function", func(t *testing.T) {
+		// --- When ---
+		have := NewValueMetadata(reflect.ValueOf(TFuncHandler)).Func()
+
+		// --- Then ---
+		assert.True(t, strings.HasSuffix(have.File(), "funcinfo_test.go"))
+		assert.True(t, have.Line() > 0)
+		assert.False(t, have.IsClosure())
+		assert.False(t, have.IsMethodValue())
+	})
+
+	t.Run("closure", func(t *testing.T) {
+		// --- Given ---
+		fn := func(a int) int { return a }
+
+		// --- When ---
+		have := NewValueMetadata(reflect.ValueOf(fn)).Func()
+
+		// --- Then ---
+		assert.True(t, strings.HasSuffix(have.File(), "funcinfo_test.go"))
+		assert.True(t, have.IsClosure())
+		assert.False(t, have.IsMethodValue())
+	})
+
+	t.Run("method value", func(t *testing.T) {
+		// --- Given ---
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		have := NewValueMetadata(reflect.ValueOf(buf.String)).Func()
+
+		// --- Then ---
+		assert.False(t, have.IsClosure())
+		assert.True(t, have.IsMethodValue())
+	})
+
+	t.Run("nil func", func(t *testing.T) {
+		// --- Given ---
+		var fn func()
+
+		// --- When ---
+		have := NewValueMetadata(reflect.ValueOf(fn)).Func()
+
+		// --- Then ---
+		assert.NotNil(t, have)
+		assert.Equal(t, "", have.File())
+		assert.Equal(t, 0, have.Line())
+	})
+}
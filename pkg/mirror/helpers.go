@@ -4,8 +4,12 @@
 package mirror
 
 import (
+	"cmp"
 	"errors"
+	"fmt"
+	"math"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -13,12 +17,52 @@ import (
 // ErrTagSyntax represents error when parsing struct field tag.
 var ErrTagSyntax = errors.New("struct field tag syntax error")
 
+// TagSyntaxError represents struct field tag syntax error.
+type TagSyntaxError struct {
+	Field    string // Struct field name.
+	Offset   int    // Byte offset of the offending fragment in the tag.
+	Fragment string // The offending fragment.
+	Err      error  // The underlying error, may be nil.
+}
+
+// newTagSyntaxError returns new instance of [TagSyntaxError].
+func newTagSyntaxError(field string, offset int, frag string, err error) error {
+	return &TagSyntaxError{
+		Field:    field,
+		Offset:   offset,
+		Fragment: frag,
+		Err:      err,
+	}
+}
+
+func (e *TagSyntaxError) Error() string {
+	msg := fmt.Sprintf(
+		"%s: field %s at offset %d: %q",
+		ErrTagSyntax,
+		e.Field,
+		e.Offset,
+		e.Fragment,
+	)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns [ErrTagSyntax] and the underlying error if set.
+func (e *TagSyntaxError) Unwrap() []error {
+	if e.Err != nil {
+		return []error{ErrTagSyntax, e.Err}
+	}
+	return []error{ErrTagSyntax}
+}
+
 // Ptr returns a pointer to any type.
 func Ptr[T any](v T) *T { return &v }
 
 // ParseTags parses a single struct field tag and returns a map of tags where
-// keys are tag names. It returns the [ErrTagSyntax] error when a string
-// describing tags is invalid.
+// keys are tag names. It returns [TagSyntaxError] wrapping [ErrTagSyntax] when
+// a string describing tags is invalid.
 //
 // Example tags:
 //
@@ -27,8 +71,10 @@ func Ptr[T any](v T) *T { return &v }
 //	`custom:"f3,required"`
 //	`custom:"f4,required, other"`
 //	`custom:",required,other"`
+//	`custom:"f5,size=36,type=uuid"`
 //
-// It will remove whitespace from options.
+// It will remove whitespace from options. Tags with keys registered with
+// [RegisterTagParser] are parsed by the registered parser.
 //
 // If the field has multiple tags with the same key, the later one overrides
 // the previous one.
@@ -44,6 +90,7 @@ func ParseTags(fieldName, stag string) ([]Tag, error) {
 	}
 
 	var tags []Tag
+	orig := stag
 
 	// NOTE(arslan) following code is from the reflect and vet package with
 	// some modifications to collect all necessary information and extend it
@@ -58,6 +105,7 @@ func ParseTags(fieldName, stag string) ([]Tag, error) {
 		if stag == "" {
 			break
 		}
+		pair, offset := stag, len(orig)-len(stag)
 
 		// Scan to colon. A space, a quote or a control character is a syntax
 		// error. Strictly speaking, control chars include the range [0x7f,
@@ -71,14 +119,9 @@ func ParseTags(fieldName, stag string) ([]Tag, error) {
 			i++
 		}
 
-		if i == 0 {
-			return nil, ErrTagSyntax
-		}
-		if i+1 >= len(stag) || stag[i] != ':' {
-			return nil, ErrTagSyntax
-		}
-		if stag[i+1] != '"' {
-			return nil, ErrTagSyntax
+		if i == 0 || i+1 >= len(stag) || stag[i] != ':' || stag[i+1] != '"' {
+			frag, _, _ := strings.Cut(pair, " ")
+			return nil, newTagSyntaxError(fieldName, offset, frag, nil)
 		}
 
 		key := stag[:i]
@@ -93,15 +136,30 @@ func ParseTags(fieldName, stag string) ([]Tag, error) {
 			i++
 		}
 		if i >= len(stag) {
-			return nil, ErrTagSyntax
+			return nil, newTagSyntaxError(fieldName, offset, pair, nil)
 		}
 
 		qvalue := stag[:i+1]
 		stag = stag[i+1:]
+		frag := pair[:len(pair)-len(stag)]
 
 		value, err := strconv.Unquote(qvalue)
 		if err != nil {
-			return nil, ErrTagSyntax
+			return nil, newTagSyntaxError(fieldName, offset, frag, err)
+		}
+
+		if parser := getTagParser(key); parser != nil {
+			parsed, err := parser(fieldName, value)
+			if err != nil {
+				return nil, newTagSyntaxError(fieldName, offset, frag, err)
+			}
+			tags = setTag(tags, Tag{
+				field: fieldName,
+				key:   key,
+				raw:   value,
+				value: parsed,
+			})
+			continue
 		}
 
 		var name string
@@ -131,26 +189,14 @@ func ParseTags(fieldName, stag string) ([]Tag, error) {
 			}
 		}
 
-		tag := Tag{
+		tags = setTag(tags, Tag{
 			field:   fieldName,
 			key:     key,
+			raw:     value,
 			name:    name,
 			options: options,
-		}
-
-		overwritten := false
-		for i := 0; i < len(tags); i++ {
-			if tags[i].key == tag.key {
-				tags[i] = tag
-				overwritten = true
-			}
-		}
-		if !overwritten {
-			if tags == nil {
-				tags = make([]Tag, 0, 2)
-			}
-			tags = append(tags, tag)
-		}
+			values:  optionValues(options),
+		})
 	}
 
 	if len(tags) == 0 {
@@ -159,6 +205,36 @@ func ParseTags(fieldName, stag string) ([]Tag, error) {
 	return tags, nil
 }
 
+// setTag adds the tag to the slice or replaces the tag with the same key.
+func setTag(tags []Tag, tag Tag) []Tag {
+	for i := 0; i < len(tags); i++ {
+		if tags[i].key == tag.key {
+			tags[i] = tag
+			return tags
+		}
+	}
+	if tags == nil {
+		tags = make([]Tag, 0, 2)
+	}
+	return append(tags, tag)
+}
+
+// optionValues returns values of "name=value" options with whitespace
+// removed around names and values. It returns nil when none of the options
+// has a value.
+func optionValues(options []string) map[string]string {
+	var values map[string]string
+	for _, opt := range options {
+		if name, value, ok := strings.Cut(opt, "="); ok {
+			if values == nil {
+				values = make(map[string]string, len(options))
+			}
+			values[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+	return values
+}
+
 // indirect returns the value that typ points to.
 // The original typ is returned if typ is not a pointer.
 func indirect(typ reflect.Type) reflect.Type {
@@ -168,6 +244,115 @@ func indirect(typ reflect.Type) reflect.Type {
 	return typ
 }
 
+// convertValue returns the value converted to the type and true on success.
+// The nil value is converted to the zero value of the type. The value is
+// converted if it is assignable to the type, convertible to the type and of
+// the same kind, or it is an integer and the type is numeric. Integers which
+// overflow the type are not converted, see [overflows].
+func convertValue(v any, typ reflect.Type) (reflect.Value, bool) {
+	val := reflect.ValueOf(v)
+	switch {
+	case !val.IsValid():
+		return reflect.Zero(typ), true
+	case val.Type().AssignableTo(typ):
+		return val, true
+	case !val.Type().ConvertibleTo(typ):
+		return reflect.Value{}, false
+	case val.Kind() == typ.Kind():
+		return val.Convert(typ), true
+	case isInteger(val.Kind()) && isNumber(typ.Kind()):
+		if overflows(val, typ) {
+			return reflect.Value{}, false
+		}
+		return val.Convert(typ), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// overflows returns true if the integer value cannot be represented by the
+// numeric type.
+func overflows(val reflect.Value, typ reflect.Type) bool {
+	if !val.IsValid() || !isInteger(val.Kind()) {
+		return false
+	}
+	signed := val.CanInt()
+	switch {
+	case typ.Kind() == reflect.Float32 || typ.Kind() == reflect.Float64:
+		if signed {
+			return typ.OverflowFloat(float64(val.Int()))
+		}
+		return typ.OverflowFloat(float64(val.Uint()))
+	case reflect.Zero(typ).CanInt():
+		if signed {
+			return typ.OverflowInt(val.Int())
+		}
+		return val.Uint() > math.MaxInt64 || typ.OverflowInt(int64(val.Uint()))
+	case reflect.Zero(typ).CanUint():
+		if signed {
+			return val.Int() < 0 || typ.OverflowUint(uint64(val.Int()))
+		}
+		return typ.OverflowUint(val.Uint())
+	default:
+		return false
+	}
+}
+
+// isInteger returns true for signed and unsigned integer kinds.
+func isInteger(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNumber returns true for integer and floating point kinds.
+func isNumber(kind reflect.Kind) bool {
+	return isInteger(kind) || kind == reflect.Float32 ||
+		kind == reflect.Float64
+}
+
+// walkStructs calls "fn" for the metadata of all struct types reachable from
+// the type. Struct types are reached through struct fields, pointers, slices,
+// arrays and maps. Each struct type is visited once.
+func walkStructs(typ reflect.Type, fn func(md *Metadata)) {
+	seen := make(map[reflect.Type]bool)
+	var walk func(typ reflect.Type)
+	walk = func(typ reflect.Type) {
+		for isContainer(typ) {
+			if typ.Kind() == reflect.Map {
+				walk(typ.Key())
+			}
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct || seen[typ] {
+			return
+		}
+		seen[typ] = true
+
+		md := ReflectType(typ)
+		fn(md)
+		for _, fld := range md.Fields() {
+			walk(fld.Type())
+		}
+	}
+	walk(typ)
+}
+
+// isContainer returns true for pointer, slice, array, channel and map types.
+func isContainer(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Chan, reflect.Map:
+		return true
+	default:
+		return false
+	}
+}
+
 // splitOnLastPeriod finds the last period in the string and returns everything
 // that was before it and after it.
 func splitOnLastPeriod(s string) (before, after string) {
@@ -177,3 +362,43 @@ func splitOnLastPeriod(s string) (before, after string) {
 	}
 	return s[:i], s[i+1:]
 }
+
+// splitFuncName splits the runtime function name into the import path and
+// the function name. The function name may have periods, for example,
+// "(*Buffer).String" or "Fn.func1".
+func splitFuncName(s string) (imp, name string) {
+	slash := strings.LastIndex(s, "/") + 1
+	i := strings.Index(s[slash:], ".")
+	if i == -1 {
+		return s, "" // no dot
+	}
+	i += slash
+	return strings.ReplaceAll(s[:i], "%2e", "."), s[i+1:]
+}
+
+// joinPath joins a path with a field name using a period.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// indexPath appends a slice or array index to the path.
+func indexPath(path string, idx int) string {
+	return path + "[" + strconv.Itoa(idx) + "]"
+}
+
+// keyPath appends a map key to the path.
+func keyPath(path string, key reflect.Value) string {
+	return path + "[" + fmt.Sprint(key) + "]"
+}
+
+// sortedKeys returns map keys sorted by their string representation.
+func sortedKeys(val reflect.Value) []reflect.Value {
+	keys := val.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	})
+	return keys
+}
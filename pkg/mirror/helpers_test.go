@@ -5,6 +5,8 @@ package mirror
 
 import (
 	"bytes"
+	"errors"
+	"math"
 	"reflect"
 	"testing"
 
@@ -39,6 +41,7 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "FieldA",
 					key:     "tag",
+					raw:     "t1",
 					name:    "t1",
 					options: nil,
 				},
@@ -52,6 +55,7 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "FieldB",
 					key:     "tag",
+					raw:     "",
 					name:    "",
 					options: nil,
 				},
@@ -65,6 +69,7 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "FieldC",
 					key:     "tag",
+					raw:     "t1,t2",
 					name:    "t1",
 					options: []string{"t2"},
 				},
@@ -78,6 +83,7 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "Field",
 					key:     "tag",
+					raw:     " t1, t2, o3 ",
 					name:    "t1",
 					options: []string{"t2", "o3"},
 				},
@@ -91,6 +97,7 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "Field",
 					key:     "tag",
+					raw:     "t1,,t2,",
 					name:    "t1",
 					options: []string{"t2"},
 				},
@@ -104,11 +111,27 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "Field",
 					key:     "tag",
+					raw:     " ",
 					name:    "Field",
 					options: nil,
 				},
 			},
 		},
+		{
+			"options with values",
+			"Field",
+			`tag:"t1,t2=v2,t3, t4 = v4 "`,
+			[]Tag{
+				{
+					field:   "Field",
+					key:     "tag",
+					raw:     "t1,t2=v2,t3, t4 = v4 ",
+					name:    "t1",
+					options: []string{"t2=v2", "t3", "t4 = v4"},
+					values:  map[string]string{"t2": "v2", "t4": "v4"},
+				},
+			},
+		},
 		{
 			"tag with leading spaces",
 			"Field",
@@ -117,6 +140,7 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "Field",
 					key:     "tag",
+					raw:     "t1,t2",
 					name:    "t1",
 					options: []string{"t2"},
 				},
@@ -130,6 +154,7 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "Field",
 					key:     "tag",
+					raw:     "t1,t2\"",
 					name:    "t1",
 					options: []string{"t2\""},
 				},
@@ -143,12 +168,14 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "Field",
 					key:     "tag",
+					raw:     "t1,t2",
 					name:    "t1",
 					options: []string{"t2"},
 				},
 				{
 					field:   "Field",
 					key:     "other",
+					raw:     "o1,o2",
 					name:    "o1",
 					options: []string{"o2"},
 				},
@@ -162,12 +189,14 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "Field",
 					key:     "tag",
+					raw:     "t3,t4",
 					name:    "t3",
 					options: []string{"t4"},
 				},
 				{
 					field:   "Field",
 					key:     "other",
+					raw:     "o1,o2",
 					name:    "o1",
 					options: []string{"o2"},
 				},
@@ -181,12 +210,14 @@ func Test_ParseTags(t *testing.T) {
 				{
 					field:   "Field",
 					key:     "tag",
+					raw:     ",t1,t2",
 					name:    "Field",
 					options: []string{"t1", "t2"},
 				},
 				{
 					field:   "Field",
 					key:     "other",
+					raw:     ",o1,o2",
 					name:    "Field",
 					options: []string{"o1", "o2"},
 				},
@@ -235,6 +266,187 @@ func Test_ParseTags_errors_tabular(t *testing.T) {
 	}
 }
 
+func Test_ParseTags_syntax_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		tags   string
+		offset int
+		frag   string
+	}{
+		{"missing quotes", `json:name`, 0, "json:name"},
+		{"second tag", `json:"a" db:id other:"b"`, 9, "db:id"},
+		{"leading spaces", `  json`, 2, "json"},
+		{"not terminated", `json:"a" db:"id`, 9, `db:"id`},
+		{"invalid escape", `json:"\19"`, 0, `json:"\19"`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			_, err := ParseTags("Field", tc.tags)
+
+			// --- Then ---
+			var e *TagSyntaxError
+			assert.True(t, errors.As(err, &e))
+			assert.Equal(t, "Field", e.Field)
+			assert.Equal(t, tc.offset, e.Offset)
+			assert.Equal(t, tc.frag, e.Fragment)
+		})
+	}
+}
+
+func Test_TagSyntaxError_Error(t *testing.T) {
+	t.Run("without underlying error", func(t *testing.T) {
+		// --- Given ---
+		e := &TagSyntaxError{Field: "F", Offset: 2, Fragment: "json:a"}
+
+		// --- When ---
+		have := e.Error()
+
+		// --- Then ---
+		want := `struct field tag syntax error: field F at offset 2: "json:a"`
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("with underlying error", func(t *testing.T) {
+		// --- Given ---
+		e := &TagSyntaxError{
+			Field:    "F",
+			Offset:   2,
+			Fragment: "json:a",
+			Err:      errors.New("abc"),
+		}
+
+		// --- When ---
+		have := e.Error()
+
+		// --- Then ---
+		want := `struct field tag syntax error: field F at offset 2: ` +
+			`"json:a": abc`
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_TagSyntaxError_Unwrap(t *testing.T) {
+	t.Run("without underlying error", func(t *testing.T) {
+		// --- Given ---
+		e := &TagSyntaxError{Field: "F"}
+
+		// --- When ---
+		have := e.Unwrap()
+
+		// --- Then ---
+		assert.Equal(t, []error{ErrTagSyntax}, have)
+	})
+
+	t.Run("with underlying error", func(t *testing.T) {
+		// --- Given ---
+		err := errors.New("abc")
+		e := &TagSyntaxError{Field: "F", Err: err}
+
+		// --- When ---
+		have := e.Unwrap()
+
+		// --- Then ---
+		assert.Equal(t, []error{ErrTagSyntax, err}, have)
+	})
+}
+
+func Test_optionValues(t *testing.T) {
+	t.Run("no values", func(t *testing.T) {
+		// --- When ---
+		have := optionValues([]string{"a", "b"})
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("nil options", func(t *testing.T) {
+		// --- When ---
+		have := optionValues(nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("values", func(t *testing.T) {
+		// --- When ---
+		have := optionValues([]string{"a", "b=1", "c=", "d=e=f"})
+
+		// --- Then ---
+		want := map[string]string{"b": "1", "c": "", "d": "e=f"}
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_convertValue_tabular(t *testing.T) {
+	type Str string
+
+	tt := []struct {
+		testN string
+
+		v    any
+		typ  reflect.Type
+		want any
+		ok   bool
+	}{
+		{"nil", nil, reflect.TypeOf(1), 0, true},
+		{"assignable", 1, reflect.TypeOf(1), 1, true},
+		{"interface", 1, reflect.TypeOf((*any)(nil)).Elem(), 1, true},
+		{"same kind", "a", reflect.TypeOf(Str("")), Str("a"), true},
+		{"integer to integer", 1, reflect.TypeOf(int8(0)), int8(1), true},
+		{"integer to float", 1, reflect.TypeOf(1.0), 1.0, true},
+		{"integer overflows", 300, reflect.TypeOf(uint8(0)), nil, false},
+		{"negative to unsigned", -1, reflect.TypeOf(uint(0)), nil, false},
+		{"float to integer", 1.5, reflect.TypeOf(1), nil, false},
+		{"integer to string", 65, reflect.TypeOf(""), nil, false},
+		{"not convertible", "a", reflect.TypeOf(1), nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, ok := convertValue(tc.v, tc.typ)
+
+			// --- Then ---
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, valueOf(have))
+		})
+	}
+}
+
+func Test_overflows_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    any
+		typ  reflect.Type
+		want bool
+	}{
+		{"int fits int8", 127, reflect.TypeOf(int8(0)), false},
+		{"int overflows int8", 128, reflect.TypeOf(int8(0)), true},
+		{"int fits uint8", 255, reflect.TypeOf(uint8(0)), false},
+		{"int overflows uint8", 256, reflect.TypeOf(uint8(0)), true},
+		{"negative to uint", -1, reflect.TypeOf(uint(0)), true},
+		{"uint64 overflows int64", uint64(1 << 63), reflect.TypeOf(0), true},
+		{"uint fits int", uint(1), reflect.TypeOf(0), false},
+		{"int to float32", math.MaxInt64, reflect.TypeOf(float32(0)), false},
+		{"not integer", 1.5, reflect.TypeOf(int8(0)), false},
+		{"not numeric type", 1, reflect.TypeOf(""), false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := overflows(reflect.ValueOf(tc.v), tc.typ)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
 func Test_indirect(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -284,3 +496,90 @@ func Test_splitOnLastPeriod_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_splitFuncName_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str   string
+		wImp  string
+		wName string
+	}{
+		{"empty", "", "", ""},
+		{"just period", ".", "", ""},
+		{"no period", "main", "main", ""},
+		{"simple", "a.b", "a", "b"},
+		{"multiple periods", "a.b.c.d", "a", "b.c.d"},
+		{"path", "a/b/c.d", "a/b/c", "d"},
+		{"dots in path", "a.io/b.c/d.e", "a.io/b.c/d", "e"},
+		{"escaped dot", "a.in/b%2ev3.C", "a.in/b.v3", "C"},
+		{"closure", "a/b.Fn.func1.2", "a/b", "Fn.func1.2"},
+		{"method value", "a.(*T).M-fm", "a", "(*T).M-fm"},
+		{"ends with period", "a/b.", "a/b", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			hImp, hName := splitFuncName(tc.str)
+
+			// --- Then ---
+			assert.Equal(t, tc.wImp, hImp)
+			assert.Equal(t, tc.wName, hName)
+		})
+	}
+}
+
+func Test_joinPath_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		path string
+		name string
+		want string
+	}{
+		{"empty path", "", "A", "A"},
+		{"path", "A", "B", "A.B"},
+		{"path with index", "A[1]", "B", "A[1].B"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := joinPath(tc.path, tc.name)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_indexPath(t *testing.T) {
+	// --- When ---
+	have := indexPath("A", 2)
+
+	// --- Then ---
+	assert.Equal(t, "A[2]", have)
+}
+
+func Test_keyPath(t *testing.T) {
+	// --- When ---
+	have := keyPath("A", reflect.ValueOf("key"))
+
+	// --- Then ---
+	assert.Equal(t, "A[key]", have)
+}
+
+func Test_sortedKeys(t *testing.T) {
+	// --- Given ---
+	m := map[string]int{"c": 3, "a": 1, "b": 2}
+
+	// --- When ---
+	have := sortedKeys(reflect.ValueOf(m))
+
+	// --- Then ---
+	assert.Len(t, 3, have)
+	assert.Equal(t, "a", have[0].String())
+	assert.Equal(t, "b", have[1].String())
+	assert.Equal(t, "c", have[2].String())
+}
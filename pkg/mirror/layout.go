@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+)
+
+// FieldLayout represents the memory layout of a struct field.
+type FieldLayout struct {
+	Name    string  // Field name.
+	Offset  uintptr // Offset of the field in the struct.
+	Size    uintptr // Size of the field.
+	Align   uintptr // Alignment of the field.
+	Padding uintptr // Padding bytes after the field.
+}
+
+// Layout represents the memory layout of a struct.
+type Layout struct {
+	Fields  []FieldLayout // Fields in memory order.
+	Size    uintptr       // Size of the struct.
+	Align   uintptr       // Alignment of the struct.
+	Padding uintptr       // Total bytes wasted to padding.
+}
+
+// Layout returns the memory layout of the struct. Returns nil for types
+// which are not structs.
+func (md *Metadata) Layout() *Layout {
+	if md.kind != reflect.Struct {
+		return nil
+	}
+	lay := &Layout{Size: md.typ.Size(), Align: uintptr(md.typ.Align())}
+	for i := 0; i < md.typ.NumField(); i++ {
+		sf := md.typ.Field(i)
+		lay.Fields = append(lay.Fields, FieldLayout{
+			Name:   sf.Name,
+			Offset: sf.Offset,
+			Size:   sf.Type.Size(),
+			Align:  uintptr(sf.Type.FieldAlign()),
+		})
+	}
+	lay.setPadding()
+	return lay
+}
+
+// SuggestFieldOrder returns the memory layout of the struct with fields
+// reordered to minimize its size. Fields are ordered by decreasing alignment
+// and size, zero-sized fields are placed first, so they do not require
+// trailing padding. Returns nil for types which are not structs.
+func (md *Metadata) SuggestFieldOrder() *Layout {
+	lay := md.Layout()
+	if lay == nil {
+		return nil
+	}
+	slices.SortStableFunc(lay.Fields, func(a, b FieldLayout) int {
+		if (a.Size == 0) != (b.Size == 0) {
+			if a.Size == 0 {
+				return -1
+			}
+			return 1
+		}
+		if c := cmp.Compare(b.Align, a.Align); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Size, a.Size)
+	})
+
+	var off uintptr
+	for i := range lay.Fields {
+		fl := &lay.Fields[i]
+		off = alignUp(off, fl.Align)
+		fl.Offset = off
+		off += fl.Size
+	}
+	if n := len(lay.Fields); n > 0 && lay.Fields[n-1].Size == 0 && off > 0 {
+		off++ // Trailing zero-sized field must not point past the struct.
+	}
+	lay.Size = alignUp(off, lay.Align)
+	lay.setPadding()
+	return lay
+}
+
+// FieldNames returns field names in memory order.
+func (lay *Layout) FieldNames() []string {
+	names := make([]string, len(lay.Fields))
+	for i, fl := range lay.Fields {
+		names[i] = fl.Name
+	}
+	return names
+}
+
+// setPadding sets padding of the fields and the total padding.
+func (lay *Layout) setPadding() {
+	lay.Padding = lay.Size
+	for i := range lay.Fields {
+		fl := &lay.Fields[i]
+		end := lay.Size
+		if i+1 < len(lay.Fields) {
+			end = lay.Fields[i+1].Offset
+		}
+		fl.Padding = end - fl.Offset - fl.Size
+		lay.Padding -= fl.Size
+	}
+}
+
+// alignUp rounds the offset up to the multiple of the alignment.
+func alignUp(off, align uintptr) uintptr {
+	if align == 0 {
+		return off
+	}
+	return (off + align - 1) / align * align
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TLayout is a type used in struct layout tests.
+type TLayout struct {
+	A bool
+	B int64
+	C bool
+	D int32
+}
+
+func Test_Metadata_Layout(t *testing.T) {
+	t.Run("padded struct", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TLayout{})
+
+		// --- When ---
+		have := md.Layout()
+
+		// --- Then ---
+		want := &Layout{
+			Fields: []FieldLayout{
+				{Name: "A", Offset: 0, Size: 1, Align: 1, Padding: 7},
+				{Name: "B", Offset: 8, Size: 8, Align: 8, Padding: 0},
+				{Name: "C", Offset: 16, Size: 1, Align: 1, Padding: 3},
+				{Name: "D", Offset: 20, Size: 4, Align: 4, Padding: 0},
+			},
+			Size:    24,
+			Align:   8,
+			Padding: 10,
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("trailing padding", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(struct {
+			A int64
+			B bool
+		}{})
+
+		// --- When ---
+		have := md.Layout()
+
+		// --- Then ---
+		assert.Equal(t, uintptr(16), have.Size)
+		assert.Equal(t, uintptr(7), have.Fields[1].Padding)
+		assert.Equal(t, uintptr(7), have.Padding)
+	})
+
+	t.Run("empty struct", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(struct{}{})
+
+		// --- When ---
+		have := md.Layout()
+
+		// --- Then ---
+		assert.Equal(t, &Layout{Align: 1}, have)
+	})
+
+	t.Run("not struct", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(42)
+
+		// --- When ---
+		have := md.Layout()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Metadata_SuggestFieldOrder(t *testing.T) {
+	t.Run("padded struct", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TLayout{})
+
+		// --- When ---
+		have := md.SuggestFieldOrder()
+
+		// --- Then ---
+		want := &Layout{
+			Fields: []FieldLayout{
+				{Name: "B", Offset: 0, Size: 8, Align: 8, Padding: 0},
+				{Name: "D", Offset: 8, Size: 4, Align: 4, Padding: 0},
+				{Name: "A", Offset: 12, Size: 1, Align: 1, Padding: 0},
+				{Name: "C", Offset: 13, Size: 1, Align: 1, Padding: 2},
+			},
+			Size:    16,
+			Align:   8,
+			Padding: 2,
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("already optimal", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(struct {
+			A int64
+			B int32
+		}{})
+
+		// --- When ---
+		have := md.SuggestFieldOrder()
+
+		// --- Then ---
+		assert.Equal(t, md.Layout(), have)
+	})
+
+	t.Run("zero sized fields first", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(struct {
+			A int64
+			B struct{}
+		}{})
+
+		// --- When ---
+		have := md.SuggestFieldOrder()
+
+		// --- Then ---
+		assert.Equal(t, []string{"B", "A"}, have.FieldNames())
+		assert.Equal(t, uintptr(8), have.Size)
+		assert.Equal(t, uintptr(16), md.Layout().Size)
+	})
+
+	t.Run("not struct", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(42)
+
+		// --- When ---
+		have := md.SuggestFieldOrder()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Layout_FieldNames(t *testing.T) {
+	t.Run("names", func(t *testing.T) {
+		// --- Given ---
+		lay := NewMetadata(TLayout{}).Layout()
+
+		// --- When ---
+		have := lay.FieldNames()
+
+		// --- Then ---
+		assert.Equal(t, []string{"A", "B", "C", "D"}, have)
+	})
+}
+
+func Test_alignUp_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		off   uintptr
+		align uintptr
+		want  uintptr
+	}{
+		{"zero", 0, 8, 0},
+		{"aligned", 8, 8, 8},
+		{"round up", 9, 8, 16},
+		{"zero alignment", 3, 0, 3},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := alignUp(tc.off, tc.align)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
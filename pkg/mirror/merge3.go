@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Conflict represents a value changed differently in both versions during
+// a three-way merge.
+type Conflict struct {
+	Path   string // Path using Go field names.
+	Base   any    // Value in the common ancestor, nil when absent.
+	Ours   any    // Value in our version, nil when absent.
+	Theirs any    // Value in their version, nil when absent.
+}
+
+// String returns conflict description.
+func (c Conflict) String() string {
+	path := c.Path
+	if path == "" {
+		path = "<root>"
+	}
+	return fmt.Sprintf(
+		"%s: base %v, ours %v, theirs %v",
+		path,
+		c.Base,
+		c.Ours,
+		c.Theirs,
+	)
+}
+
+// Merge3 performs a three-way merge of "ours" and "theirs" versions of the
+// value derived from the common "base". The result is a new value of the same
+// type as the arguments, inputs are never modified.
+//
+// Values are merged field by field: a value changed only on one side is taken
+// from that side, a value changed the same way on both sides is taken as is.
+// Nested structs, pointers to structs and maps changed on both sides are
+// merged recursively. Structs without exported fields or with the "Equal"
+// method, for example, [time.Time], are treated as single values. Other
+// values changed differently on both sides are reported as conflicts and our
+// version is used in the result.
+//
+// Values are compared with [Equal], so unexported fields and fields with the
+// "-" name in the "cmp" tag are taken from our version.
+//
+// When argument types differ, it returns nil and a conflict for the root.
+func Merge3(base, ours, theirs any) (any, []Conflict) {
+	bv := reflect.ValueOf(base)
+	ov := reflect.ValueOf(ours)
+	tv := reflect.ValueOf(theirs)
+	if !ov.IsValid() || !bv.IsValid() || !tv.IsValid() ||
+		ov.Type() != bv.Type() || ov.Type() != tv.Type() {
+
+		if base == nil && ours == nil && theirs == nil {
+			return nil, nil
+		}
+		return nil, []Conflict{{Base: base, Ours: ours, Theirs: theirs}}
+	}
+
+	var conflicts []Conflict
+	dst := reflect.New(ov.Type()).Elem()
+	dst.Set(cloneValue(ov))
+	merge3(&conflicts, dst, bv, ov, tv, "")
+	return dst.Interface(), conflicts
+}
+
+// merge3 merges values into "dst" which must be settable and contain a copy
+// of "ours". Invalid values represent missing map entries.
+func merge3(
+	conflicts *[]Conflict,
+	dst, base, ours, theirs reflect.Value,
+	path string,
+) {
+
+	if equalValues(ours, theirs) || equalValues(base, theirs) {
+		return
+	}
+	if equalValues(base, ours) {
+		dst.Set(cloneValue(theirs))
+		return
+	}
+
+	if ours.IsValid() && theirs.IsValid() {
+		switch ours.Kind() {
+		case reflect.Struct:
+			if isMergeStruct(ours.Type()) {
+				merge3Struct(conflicts, dst, base, ours, theirs, path)
+				return
+			}
+
+		case reflect.Ptr:
+			if !ours.IsNil() && !theirs.IsNil() &&
+				isMergeStruct(ours.Type().Elem()) {
+
+				base = zeroIfNil(base, ours.Type())
+				merge3(
+					conflicts,
+					dst.Elem(),
+					base.Elem(),
+					ours.Elem(),
+					theirs.Elem(),
+					path,
+				)
+				return
+			}
+
+		case reflect.Map:
+			if !ours.IsNil() && !theirs.IsNil() {
+				merge3Map(conflicts, dst, base, ours, theirs, path)
+				return
+			}
+
+		default:
+			// Conflict.
+		}
+	}
+
+	*conflicts = append(*conflicts, Conflict{
+		Path:   path,
+		Base:   valueOf(base),
+		Ours:   valueOf(ours),
+		Theirs: valueOf(theirs),
+	})
+}
+
+// merge3Struct merges exported struct fields.
+func merge3Struct(
+	conflicts *[]Conflict,
+	dst, base, ours, theirs reflect.Value,
+	path string,
+) {
+
+	if !base.IsValid() {
+		base = reflect.Zero(ours.Type())
+	}
+	sv := NewStructValue(dst.Addr().Interface())
+	for i := 0; i < sv.NumField(); i++ {
+		fv := sv.FieldByIndex(i)
+		if !fv.IsExported() {
+			continue
+		}
+		merge3(
+			conflicts,
+			fv.Value(),
+			base.Field(i),
+			ours.Field(i),
+			theirs.Field(i),
+			joinPath(path, fv.Name()),
+		)
+	}
+}
+
+// isMergeStruct returns true for struct types which are merged field by
+// field. Structs without exported fields or with the "Equal" method are
+// merged as single values.
+func isMergeStruct(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Struct || equalMethod(typ) >= 0 {
+		return false
+	}
+	for _, fld := range ReflectType(typ).Fields() {
+		if fld.IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// merge3Map merges map entries.
+func merge3Map(
+	conflicts *[]Conflict,
+	dst, base, ours, theirs reflect.Value,
+	path string,
+) {
+
+	if !base.IsValid() {
+		base = reflect.Zero(ours.Type())
+	}
+	for _, key := range unionKeys(base, ours, theirs) {
+		bv := base.MapIndex(key)
+		ov := ours.MapIndex(key)
+		tv := theirs.MapIndex(key)
+		kPath := keyPath(path, key)
+
+		switch {
+		case equalValues(ov, tv), equalValues(bv, tv):
+			// Keep ours.
+
+		case equalValues(bv, ov):
+			if tv.IsValid() {
+				tv = cloneValue(tv)
+			}
+			dst.SetMapIndex(key, tv) // Deletes the key for invalid value.
+
+		case ov.IsValid() && tv.IsValid():
+			elem := reflect.New(ours.Type().Elem()).Elem()
+			elem.Set(dst.MapIndex(key))
+			merge3(conflicts, elem, bv, ov, tv, kPath)
+			dst.SetMapIndex(key, elem)
+
+		default:
+			*conflicts = append(*conflicts, Conflict{
+				Path:   kPath,
+				Base:   valueOf(bv),
+				Ours:   valueOf(ov),
+				Theirs: valueOf(tv),
+			})
+		}
+	}
+}
+
+// unionKeys returns sorted keys present in any of the maps of the same type.
+func unionKeys(maps ...reflect.Value) []reflect.Value {
+	typ := reflect.MapOf(maps[0].Type().Key(), reflect.TypeFor[struct{}]())
+	union := reflect.MakeMap(typ)
+	for _, m := range maps {
+		iter := m.MapRange()
+		for iter.Next() {
+			union.SetMapIndex(iter.Key(), reflect.ValueOf(struct{}{}))
+		}
+	}
+	return sortedKeys(union)
+}
+
+// zeroIfNil returns a pointer to a new zero value if the pointer is nil or
+// invalid.
+func zeroIfNil(ptr reflect.Value, typ reflect.Type) reflect.Value {
+	if !ptr.IsValid() || ptr.IsNil() {
+		return reflect.New(typ.Elem())
+	}
+	return ptr
+}
+
+// equalValues returns true if values are equal using [Equal] rules. Two
+// invalid values are equal.
+func equalValues(a, b reflect.Value) bool {
+	c := newComparer(newCompareOpts(), true)
+	c.compare(a, b, "", "")
+	return len(c.changes) == 0
+}
@@ -0,0 +1,514 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"math/big"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TMerge is a struct used in merge tests.
+type TMerge struct {
+	Title  string
+	Body   string
+	Tags   []string
+	Meta   map[string]string
+	Author *TMergeAuthor
+	Stats  TMergeStats
+	Skip   int `cmp:"-"`
+	priv   int
+}
+
+// TMergeAuthor is a struct used in merge tests.
+type TMergeAuthor struct {
+	Name  string
+	Email string
+}
+
+// TMergeVersion is a struct with the "Equal" method used in merge tests.
+type TMergeVersion struct {
+	Major int
+	Minor int
+}
+
+func (v TMergeVersion) Equal(o TMergeVersion) bool { return v == o }
+
+// TMergeStats is a struct used in merge tests.
+type TMergeStats struct {
+	Views int
+	Likes int
+}
+
+func Test_Conflict_String(t *testing.T) {
+	t.Run("path", func(t *testing.T) {
+		// --- Given ---
+		c := Conflict{Path: "A", Base: 1, Ours: 2, Theirs: 3}
+
+		// --- When ---
+		have := c.String()
+
+		// --- Then ---
+		assert.Equal(t, "A: base 1, ours 2, theirs 3", have)
+	})
+
+	t.Run("root", func(t *testing.T) {
+		// --- Given ---
+		c := Conflict{Base: 1, Ours: 2, Theirs: 3}
+
+		// --- When ---
+		have := c.String()
+
+		// --- Then ---
+		assert.Equal(t, "<root>: base 1, ours 2, theirs 3", have)
+	})
+}
+
+func Test_Merge3(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Title: "t"}
+
+		// --- When ---
+		have, conflicts := Merge3(base, base, base)
+
+		// --- Then ---
+		assert.Equal(t, base, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("changes from both sides", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Title: "t", Body: "b"}
+		ours := TMerge{Title: "T", Body: "b"}
+		theirs := TMerge{Title: "t", Body: "B"}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, TMerge{Title: "T", Body: "B"}, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("same change on both sides", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Title: "t"}
+		ours := TMerge{Title: "T"}
+		theirs := TMerge{Title: "T"}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, TMerge{Title: "T"}, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Title: "t", Body: "b"}
+		ours := TMerge{Title: "ours", Body: "b"}
+		theirs := TMerge{Title: "theirs", Body: "B"}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, TMerge{Title: "ours", Body: "B"}, have)
+		want := []Conflict{
+			{Path: "Title", Base: "t", Ours: "ours", Theirs: "theirs"},
+		}
+		assert.Equal(t, want, conflicts)
+	})
+
+	t.Run("slices are not merged", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Tags: []string{"a"}}
+		ours := TMerge{Tags: []string{"a", "b"}}
+		theirs := TMerge{Tags: []string{"a", "c"}}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, ours, have)
+		assert.Len(t, 1, conflicts)
+		assert.Equal(t, "Tags", conflicts[0].Path)
+	})
+
+	t.Run("nested struct", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Stats: TMergeStats{Views: 1, Likes: 1}}
+		ours := TMerge{Stats: TMergeStats{Views: 2, Likes: 1}}
+		theirs := TMerge{Stats: TMergeStats{Views: 1, Likes: 2}}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		want := TMerge{Stats: TMergeStats{Views: 2, Likes: 2}}
+		assert.Equal(t, want, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("time changed on both sides conflicts", func(t *testing.T) {
+		// --- Given ---
+		t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		type T struct{ At time.Time }
+		base := T{At: t0}
+		ours := T{At: t0.Add(time.Hour)}
+		theirs := T{At: t0.Add(2 * time.Hour)}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, ours, have)
+		want := []Conflict{
+			{Path: "At", Base: base.At, Ours: ours.At, Theirs: theirs.At},
+		}
+		assert.Equal(t, want, conflicts)
+	})
+
+	t.Run("time changed on one side", func(t *testing.T) {
+		// --- Given ---
+		t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		type T struct{ At time.Time }
+		base := T{At: t0}
+		ours := T{At: t0}
+		theirs := T{At: t0.Add(time.Hour)}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, theirs, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("pointer to time conflicts", func(t *testing.T) {
+		// --- Given ---
+		t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		t1 := t0.Add(time.Hour)
+		t2 := t0.Add(2 * time.Hour)
+		type T struct{ At *time.Time }
+		base := T{At: &t0}
+		ours := T{At: &t1}
+		theirs := T{At: &t2}
+
+		// --- When ---
+		_, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Len(t, 1, conflicts)
+		assert.Equal(t, "At", conflicts[0].Path)
+	})
+
+	t.Run("struct with equal method conflicts", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ V TMergeVersion }
+		base := T{V: TMergeVersion{Major: 1, Minor: 1}}
+		ours := T{V: TMergeVersion{Major: 2, Minor: 1}}
+		theirs := T{V: TMergeVersion{Major: 1, Minor: 2}}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, ours, have)
+		assert.Len(t, 1, conflicts)
+		assert.Equal(t, "V", conflicts[0].Path)
+	})
+
+	t.Run("opaque struct changed on one side", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Num  *big.Int
+			Addr netip.Addr
+		}
+		ip1 := netip.MustParseAddr("1.1.1.1")
+		ip2 := netip.MustParseAddr("2.2.2.2")
+		base := T{Num: big.NewInt(1), Addr: ip1}
+		ours := T{Num: big.NewInt(1), Addr: ip1}
+		theirs := T{Num: big.NewInt(100), Addr: ip2}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Nil(t, conflicts)
+		assert.Equal(t, int64(100), have.(T).Num.Int64())
+		assert.Equal(t, ip2, have.(T).Addr)
+	})
+
+	t.Run("opaque struct conflicts", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ Addr netip.Addr }
+		base := T{Addr: netip.MustParseAddr("1.1.1.1")}
+		ours := T{Addr: netip.MustParseAddr("2.2.2.2")}
+		theirs := T{Addr: netip.MustParseAddr("3.3.3.3")}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, ours, have)
+		want := []Conflict{{
+			Path:   "Addr",
+			Base:   base.Addr,
+			Ours:   ours.Addr,
+			Theirs: theirs.Addr,
+		}}
+		assert.Equal(t, want, conflicts)
+	})
+
+	t.Run("root time conflicts", func(t *testing.T) {
+		// --- Given ---
+		t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		t1 := t0.Add(time.Hour)
+		t2 := t0.Add(2 * time.Hour)
+
+		// --- When ---
+		have, conflicts := Merge3(t0, t1, t2)
+
+		// --- Then ---
+		assert.Equal(t, t1, have)
+		want := []Conflict{{Base: t0, Ours: t1, Theirs: t2}}
+		assert.Equal(t, want, conflicts)
+	})
+
+	t.Run("pointer to struct", func(t *testing.T) {
+		// --- Given ---
+		base := &TMerge{Author: &TMergeAuthor{Name: "n", Email: "e"}}
+		ours := &TMerge{Author: &TMergeAuthor{Name: "N", Email: "e"}}
+		theirs := &TMerge{Author: &TMergeAuthor{Name: "n", Email: "E"}}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		want := &TMerge{Author: &TMergeAuthor{Name: "N", Email: "E"}}
+		assert.Equal(t, want, have)
+		assert.Nil(t, conflicts)
+		assert.NotSame(t, ours, have)
+		assert.NotSame(t, ours.Author, have.(*TMerge).Author)
+		assert.Equal(t, "n", base.Author.Name)
+		assert.Equal(t, "N", ours.Author.Name)
+		assert.Equal(t, "e", ours.Author.Email)
+	})
+
+	t.Run("pointer with nil base", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{}
+		ours := TMerge{Author: &TMergeAuthor{Name: "N"}}
+		theirs := TMerge{Author: &TMergeAuthor{Email: "E"}}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		want := TMerge{Author: &TMergeAuthor{Name: "N", Email: "E"}}
+		assert.Equal(t, want, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("pointer set to nil conflicts", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Author: &TMergeAuthor{Name: "n"}}
+		ours := TMerge{Author: nil}
+		theirs := TMerge{Author: &TMergeAuthor{Name: "N"}}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, ours, have)
+		assert.Len(t, 1, conflicts)
+		assert.Equal(t, "Author", conflicts[0].Path)
+		assert.Nil(t, conflicts[0].Ours)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Meta: map[string]string{
+			"keep":     "1",
+			"ours":     "1",
+			"theirs":   "1",
+			"del-ours": "1",
+			"del-them": "1",
+		}}
+		ours := TMerge{Meta: map[string]string{
+			"keep":     "1",
+			"ours":     "2",
+			"theirs":   "1",
+			"del-them": "1",
+			"add-ours": "1",
+		}}
+		theirs := TMerge{Meta: map[string]string{
+			"keep":     "1",
+			"ours":     "1",
+			"theirs":   "2",
+			"del-ours": "1",
+			"add-them": "1",
+		}}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		want := TMerge{Meta: map[string]string{
+			"keep":     "1",
+			"ours":     "2",
+			"theirs":   "2",
+			"add-ours": "1",
+			"add-them": "1",
+		}}
+		assert.Equal(t, want, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("map conflicts", func(t *testing.T) {
+		// --- Given ---
+		base := map[string]string{"a": "1", "b": "1"}
+		ours := map[string]string{"a": "2", "b": "2", "c": "1"}
+		theirs := map[string]string{"a": "3", "c": "2"}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, ours, have)
+		want := []Conflict{
+			{Path: "[a]", Base: "1", Ours: "2", Theirs: "3"},
+			{Path: "[b]", Base: "1", Ours: "2", Theirs: nil},
+			{Path: "[c]", Base: nil, Ours: "1", Theirs: "2"},
+		}
+		assert.Equal(t, want, conflicts)
+	})
+
+	t.Run("map of structs", func(t *testing.T) {
+		// --- Given ---
+		base := map[string]TMergeStats{"a": {Views: 1, Likes: 1}}
+		ours := map[string]TMergeStats{"a": {Views: 2, Likes: 1}}
+		theirs := map[string]TMergeStats{"a": {Views: 1, Likes: 2}}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		want := map[string]TMergeStats{"a": {Views: 2, Likes: 2}}
+		assert.Equal(t, want, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("nil base map", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{}
+		ours := TMerge{Meta: map[string]string{"a": "1"}}
+		theirs := TMerge{Meta: map[string]string{"b": "1"}}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		want := TMerge{Meta: map[string]string{"a": "1", "b": "1"}}
+		assert.Equal(t, want, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("unexported and ignored fields are ours", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Skip: 1, priv: 1}
+		ours := TMerge{Skip: 2, priv: 2}
+		theirs := TMerge{Skip: 3, priv: 3}
+
+		// --- When ---
+		have, conflicts := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, ours, have)
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("inputs are not modified", func(t *testing.T) {
+		// --- Given ---
+		base := TMerge{Meta: map[string]string{"a": "1"}}
+		ours := TMerge{Meta: map[string]string{"a": "1", "b": "1"}}
+		theirs := TMerge{Meta: map[string]string{"a": "2"}}
+
+		// --- When ---
+		have, _ := Merge3(base, ours, theirs)
+
+		// --- Then ---
+		assert.Equal(t, map[string]string{"a": "1"}, base.Meta)
+		assert.Equal(t, map[string]string{"a": "1", "b": "1"}, ours.Meta)
+		assert.Equal(t, map[string]string{"a": "2"}, theirs.Meta)
+		want := map[string]string{"a": "2", "b": "1"}
+		assert.Equal(t, want, have.(TMerge).Meta)
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		// --- When ---
+		have, conflicts := Merge3(1, 2, "3")
+
+		// --- Then ---
+		assert.Nil(t, have)
+		assert.Equal(t, []Conflict{{Base: 1, Ours: 2, Theirs: "3"}}, conflicts)
+	})
+
+	t.Run("all nil", func(t *testing.T) {
+		// --- When ---
+		have, conflicts := Merge3(nil, nil, nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+		assert.Nil(t, conflicts)
+	})
+}
+
+func Test_isMergeStruct_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		typ  reflect.Type
+		want bool
+	}{
+		{"exported fields", reflect.TypeOf(TMergeStats{}), true},
+		{"time", reflect.TypeOf(time.Time{}), false},
+		{"equal method", reflect.TypeOf(TMergeVersion{}), false},
+		{"only unexported fields", reflect.TypeOf(struct{ a int }{}), false},
+		{"no fields", reflect.TypeOf(struct{}{}), false},
+		{"not struct", reflect.TypeOf(1), false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := isMergeStruct(tc.typ)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_unionKeys(t *testing.T) {
+	// --- Given ---
+	a := map[string]int{"c": 1, "a": 1}
+	b := map[string]int{"b": 1, "a": 1}
+
+	// --- When ---
+	have := unionKeys(reflect.ValueOf(a), reflect.ValueOf(b))
+
+	// --- Then ---
+	assert.Len(t, 3, have)
+	assert.Equal(t, "a", have[0].String())
+	assert.Equal(t, "b", have[1].String())
+	assert.Equal(t, "c", have[2].String())
+}
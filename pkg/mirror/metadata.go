@@ -6,6 +6,7 @@ package mirror
 import (
 	"reflect"
 	"runtime"
+	"sync"
 )
 
 // Metadata represents struct metadata.
@@ -15,6 +16,18 @@ type Metadata struct {
 	fields []*Field     // Struct fields. Nil when the struct has no fields.
 	name   string       // Type name when, may be empty.
 	pkg    string       // Type import string, may be empty.
+	errs   []error      // Field tag errors. Nil when there are none.
+	fn     *FuncInfo    // Function metadata. Nil for other kinds.
+
+	comparable bool     // Are values of the type comparable.
+	impls      sync.Map // Interface implementation bits by interface type.
+
+	methods     []*Method // Exported methods, lazily initialized.
+	methodsOnce sync.Once // Guards methods initialization.
+
+	elem     *Metadata // Element type metadata, lazily initialized.
+	key      *Metadata // Map key type metadata, lazily initialized.
+	elemOnce sync.Once // Guards elem and key initialization.
 }
 
 // NewMetadata extracts [Metadata] about type of "v". Panics for nil value.
@@ -25,34 +38,70 @@ func NewMetadata(v any) *Metadata {
 // NewTypeMetadata extracts [Metadata] for the type. Panics when type represents
 // nil value.
 func NewTypeMetadata(typ reflect.Type) *Metadata {
+	return newTypeMetadata(typ, make(map[reflect.Type]*Metadata))
+}
+
+// newTypeMetadata extracts [Metadata] for the type. The "seen" map keeps
+// track of types which are being extracted, so recursive types reuse the same
+// instance instead of recursing infinitely.
+func newTypeMetadata(
+	typ reflect.Type,
+	seen map[reflect.Type]*Metadata,
+) *Metadata {
 	typ = indirect(typ)
+	if md, ok := seen[typ]; ok {
+		return md
+	}
 	md := &Metadata{
-		typ:  typ,
-		kind: typ.Kind(),
-		name: typ.Name(),
-		pkg:  typ.PkgPath(),
+		typ:        typ,
+		kind:       typ.Kind(),
+		name:       typ.Name(),
+		pkg:        typ.PkgPath(),
+		comparable: typ.Comparable(),
+	}
+	seen[typ] = md
+	if md.kind == reflect.Struct {
+		md.getFields(seen)
 	}
-	if md.IsStruct() {
-		md.getFields()
+	if md.kind == reflect.Func {
+		md.fn = newFuncInfo(typ, seen)
 	}
 	return md
 }
 
-// NewValueMetadata extracts [Metadata] about type of "v".
+// NewValueMetadata extracts [Metadata] about type of "v". For function values
+// the metadata also has the function identity, see [FuncInfo].
 func NewValueMetadata(val reflect.Value) *Metadata {
-	typ := val.Type()
-	md := NewTypeMetadata(typ)
-	if md.kind == reflect.Func && md.name == "" {
-		if val.IsValid() && val.Pointer() != 0 {
-			if fn := runtime.FuncForPC(val.Pointer()); fn != nil {
-				name := fn.Name()
-				md.pkg, md.name = splitOnLastPeriod(name)
-			}
-		}
+	md := NewTypeMetadata(val.Type())
+	if fn := funcForValue(val); fn != nil {
+		md = md.withFunc(fn)
 	}
 	return md
 }
 
+// funcForValue returns the runtime function for the function value. Returns
+// nil for other kinds, nil functions, or when the function is not known.
+func funcForValue(val reflect.Value) *runtime.Func {
+	if val.Kind() != reflect.Func || val.Pointer() == 0 {
+		return nil
+	}
+	return runtime.FuncForPC(val.Pointer())
+}
+
+// withFunc returns a copy of the function type metadata with the identity of
+// the runtime function. The copy shares the type level metadata. The name
+// and package are set only for unnamed function types.
+func (md *Metadata) withFunc(fn *runtime.Func) *Metadata {
+	cp := &Metadata{typ: md.typ, kind: md.kind, name: md.name, pkg: md.pkg}
+	if cp.name == "" {
+		cp.pkg, cp.name = splitFuncName(fn.Name())
+	}
+	fi := *md.fn
+	fi.setSource(fn)
+	cp.fn = &fi
+	return cp
+}
+
 // Type returns struct type.
 func (md *Metadata) Type() reflect.Type { return md.typ }
 
@@ -70,6 +119,78 @@ func (md *Metadata) IsStruct() bool {
 	return indirect(md.typ).Kind() == reflect.Struct
 }
 
+// Elem returns metadata of the element type for slice, array, map, pointer
+// and channel types. Returns nil for other kinds. As with all metadata, the
+// returned metadata describes the element type after indirection, so for
+// []*User it describes User, use [Metadata.ElemType] to get the exact type.
+func (md *Metadata) Elem() *Metadata {
+	md.initElem()
+	return md.elem
+}
+
+// Key returns metadata of the map key type after indirection. Returns nil for
+// other kinds, see [Metadata.KeyType] for the exact type.
+func (md *Metadata) Key() *Metadata {
+	md.initElem()
+	return md.key
+}
+
+// ElemType returns the exact element type for slice, array, map, pointer
+// and channel types, so for []*User it returns *User. Returns nil for other
+// kinds.
+func (md *Metadata) ElemType() reflect.Type {
+	switch md.kind {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Ptr, reflect.Chan:
+		return md.typ.Elem()
+	default:
+		return nil
+	}
+}
+
+// KeyType returns the exact map key type. Returns nil for other kinds.
+func (md *Metadata) KeyType() reflect.Type {
+	if md.kind != reflect.Map {
+		return nil
+	}
+	return md.typ.Key()
+}
+
+// Len returns the array length. Returns zero for other kinds.
+func (md *Metadata) Len() int {
+	if md.kind != reflect.Array {
+		return 0
+	}
+	return md.typ.Len()
+}
+
+// ChanDir returns the channel direction. Returns zero for other kinds.
+func (md *Metadata) ChanDir() reflect.ChanDir {
+	if md.kind != reflect.Chan {
+		return 0
+	}
+	return md.typ.ChanDir()
+}
+
+// initElem initializes element and key metadata using the global cache.
+func (md *Metadata) initElem() {
+	md.elemOnce.Do(func() {
+		switch md.kind {
+		case reflect.Map:
+			md.key = ReflectType(md.typ.Key())
+			md.elem = ReflectType(md.typ.Elem())
+
+		case reflect.Slice, reflect.Array, reflect.Ptr, reflect.Chan:
+			md.elem = ReflectType(md.typ.Elem())
+
+		default:
+			// No element type.
+		}
+	})
+}
+
+// Func returns function metadata or nil if the type is not a function.
+func (md *Metadata) Func() *FuncInfo { return md.fn }
+
 // Fields returns structure fields. The slice must be considered as read-only.
 func (md *Metadata) Fields() []*Field { return md.fields }
 
@@ -83,6 +204,18 @@ func (md *Metadata) FieldByName(name string) *Field {
 	return nil
 }
 
+// FieldByPolicyName returns a struct field with the external name given by
+// the naming policy or nil if the field doesn't exist. Fields ignored by the
+// policy are skipped.
+func (md *Metadata) FieldByPolicyName(np NamingPolicy, name string) *Field {
+	for _, fld := range md.fields {
+		if !np.IsIgnored(fld) && np.Name(fld) == name {
+			return fld
+		}
+	}
+	return nil
+}
+
 // FieldByIndex returns the field at the specified index in the struct. If the
 // index is out of range, it returns nil.
 func (md *Metadata) FieldByIndex(idx int) *Field {
@@ -92,14 +225,40 @@ func (md *Metadata) FieldByIndex(idx int) *Field {
 	return md.fields[idx]
 }
 
+// Methods returns exported methods of the type sorted by name. For
+// non-interface types, it includes methods with pointer receivers, see
+// [Method.IsPtrReceiver]. The slice must be considered as read-only.
+func (md *Metadata) Methods() []*Method {
+	md.methodsOnce.Do(func() { md.methods = getMethods(md.typ) })
+	return md.methods
+}
+
+// MethodByName returns exported method by name or nil if the method doesn't
+// exist.
+func (md *Metadata) MethodByName(name string) *Method {
+	for _, m := range md.Methods() {
+		if m.name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// Errors returns struct field tag errors. It returns nil when all tags are
+// valid. The slice must be considered as read-only.
+func (md *Metadata) Errors() []error { return md.errs }
+
 // getFields gets all struct fields.
-func (md *Metadata) getFields() {
+func (md *Metadata) getFields(seen map[reflect.Type]*Metadata) {
 	nf := md.typ.NumField()
 	if nf == 0 {
 		return
 	}
 	md.fields = make([]*Field, nf)
 	for i := 0; i < nf; i++ {
-		md.fields[i] = NewField(md.typ.Field(i))
+		md.fields[i] = newField(md.typ.Field(i), seen)
+		if err := md.fields[i].TagError(); err != nil {
+			md.errs = append(md.errs, err)
+		}
 	}
 }
@@ -82,7 +82,17 @@ func Test_NewMetadata(t *testing.T) {
 }
 
 func Test_NewTypeMetadata(t *testing.T) {
-	// Tested by Test_NewMetadata.
+	t.Run("recursive type", func(t *testing.T) {
+		// --- Given ---
+		typ := reflect.TypeOf(TValidateNode{})
+
+		// --- When ---
+		have := NewTypeMetadata(typ)
+
+		// --- Then ---
+		assert.Len(t, 2, have.fields)
+		assert.Same(t, have, have.fields[1].TypeMetadata())
+	})
 }
 
 func Test_NewValueMetadata(t *testing.T) {
@@ -133,6 +143,264 @@ func Test_NewValueMetadata(t *testing.T) {
 		assert.Equal(t, "After", have.name)
 		assert.Equal(t, "github.com/ctx42/testing/pkg/check", have.pkg)
 	})
+
+	t.Run("closure", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf(func() {})
+
+		// --- When ---
+		have := NewValueMetadata(val)
+
+		// --- Then ---
+		assert.Equal(t, "Test_NewValueMetadata.func5.1", have.name)
+		assert.Equal(t, "github.com/ctx42/mirror/pkg/mirror", have.pkg)
+	})
+
+	t.Run("method value", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf((&bytes.Buffer{}).String)
+
+		// --- When ---
+		have := NewValueMetadata(val)
+
+		// --- Then ---
+		assert.Equal(t, "(*Buffer).String-fm", have.name)
+		assert.Equal(t, "bytes", have.pkg)
+	})
+}
+
+func Test_Metadata_Elem(t *testing.T) {
+	t.Run("slice of pointers", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf([]*TStruct{}))
+
+		// --- When ---
+		have := md.Elem()
+
+		// --- Then ---
+		assert.Same(t, ReflectType(reflect.TypeOf(TStruct{})), have)
+		assert.True(t, have.IsStruct())
+		assert.Same(t, have, md.Elem())
+	})
+
+	t.Run("array", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf([2]string{}))
+
+		// --- When ---
+		have := md.Elem()
+
+		// --- Then ---
+		assert.Equal(t, reflect.String, have.Kind())
+	})
+
+	t.Run("map", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(map[string]TStruct{}))
+
+		// --- When ---
+		have := md.Elem()
+
+		// --- Then ---
+		assert.Equal(t, "TStruct", have.Name())
+	})
+
+	t.Run("pointer to pointer", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(ptr(&TStruct{})))
+
+		// --- When ---
+		have := md.Elem()
+
+		// --- Then ---
+		assert.Equal(t, reflect.Ptr, md.Kind())
+		assert.Equal(t, "TStruct", have.Name())
+	})
+
+	t.Run("channel", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(make(chan int)))
+
+		// --- When ---
+		have := md.Elem()
+
+		// --- Then ---
+		assert.Equal(t, reflect.Int, have.Kind())
+	})
+
+	t.Run("recursive type", func(t *testing.T) {
+		// --- Given ---
+		type list []list
+		md := ReflectType(reflect.TypeOf(list{}))
+
+		// --- When ---
+		have := md.Elem()
+
+		// --- Then ---
+		assert.Same(t, md, have)
+	})
+
+	t.Run("no element", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(TStruct{}))
+
+		// --- When ---
+		have := md.Elem()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Metadata_Key(t *testing.T) {
+	t.Run("map", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(map[*TStruct]int{}))
+
+		// --- When ---
+		have := md.Key()
+
+		// --- Then ---
+		assert.Equal(t, "TStruct", have.Name())
+	})
+
+	t.Run("not map", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf([]int{}))
+
+		// --- When ---
+		have := md.Key()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Metadata_ElemType(t *testing.T) {
+	t.Run("slice of pointers", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf([]*TStruct{}))
+
+		// --- When ---
+		have := md.ElemType()
+
+		// --- Then ---
+		assert.Equal(t, reflect.TypeOf(&TStruct{}), have)
+		assert.Equal(t, reflect.Ptr, have.Kind())
+	})
+
+	t.Run("map of pointers", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(map[string]*TStruct{}))
+
+		// --- When ---
+		have := md.ElemType()
+
+		// --- Then ---
+		assert.Equal(t, reflect.TypeOf(&TStruct{}), have)
+	})
+
+	t.Run("no element", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(TStruct{}))
+
+		// --- When ---
+		have := md.ElemType()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Metadata_KeyType(t *testing.T) {
+	t.Run("map with pointer keys", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(map[*TStruct]int{}))
+
+		// --- When ---
+		have := md.KeyType()
+
+		// --- Then ---
+		assert.Equal(t, reflect.TypeOf(&TStruct{}), have)
+	})
+
+	t.Run("not map", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf([]int{}))
+
+		// --- When ---
+		have := md.KeyType()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Metadata_Len(t *testing.T) {
+	t.Run("array", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf([3]int{}))
+
+		// --- When ---
+		have := md.Len()
+
+		// --- Then ---
+		assert.Equal(t, 3, have)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf([]int{1, 2}))
+
+		// --- When ---
+		have := md.Len()
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+	})
+}
+
+func Test_Metadata_ChanDir(t *testing.T) {
+	t.Run("receive only", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(make(<-chan int)))
+
+		// --- When ---
+		have := md.ChanDir()
+
+		// --- Then ---
+		assert.Equal(t, reflect.RecvDir, have)
+	})
+
+	t.Run("not channel", func(t *testing.T) {
+		// --- Given ---
+		md := NewTypeMetadata(reflect.TypeOf(42))
+
+		// --- When ---
+		have := md.ChanDir()
+
+		// --- Then ---
+		assert.Equal(t, reflect.ChanDir(0), have)
+	})
+}
+
+func Test_Metadata_Func(t *testing.T) {
+	t.Run("func", func(t *testing.T) {
+		// --- When ---
+		have := NewTypeMetadata(reflect.TypeOf(reflect.Append)).Func()
+
+		// --- Then ---
+		assert.NotNil(t, have)
+		assert.True(t, have.IsVariadic())
+	})
+
+	t.Run("not func", func(t *testing.T) {
+		// --- When ---
+		have := NewTypeMetadata(reflect.TypeOf(42)).Func()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
 }
 
 func Test_Metadata_Type(t *testing.T) {
@@ -317,6 +585,106 @@ func Test_Metadata_FieldByName(t *testing.T) {
 	})
 }
 
+func Test_Metadata_Methods(t *testing.T) {
+	t.Run("methods", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(&TMethods{})
+
+		// --- When ---
+		have := md.Methods()
+
+		// --- Then ---
+		assert.Len(t, 7, have)
+		assert.Same(t, have[0], md.Methods()[0])
+	})
+
+	t.Run("no methods", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(struct{}{})
+
+		// --- When ---
+		have := md.Methods()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Metadata_MethodByName(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TMethods{})
+
+		// --- When ---
+		have := md.MethodByName("Set")
+
+		// --- Then ---
+		assert.NotNil(t, have)
+		assert.Equal(t, "Set(int)", have.String())
+		assert.True(t, have.IsPtrReceiver())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TMethods{})
+
+		// --- When ---
+		have := md.MethodByName("unexported")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Metadata_FieldByPolicyName(t *testing.T) {
+	t.Run("found by tag", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TNaming{})
+
+		// --- When ---
+		have := md.FieldByPolicyName(TagPolicy("db", "json"), "json1")
+
+		// --- Then ---
+		assert.NotNil(t, have)
+		assert.Equal(t, "F1", have.Name())
+	})
+
+	t.Run("found by fallback", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TNaming{})
+		np := NamingPolicy{Keys: []string{"json"}, Fallback: LowerCase}
+
+		// --- When ---
+		have := md.FieldByPolicyName(np, "f3")
+
+		// --- Then ---
+		assert.NotNil(t, have)
+		assert.Equal(t, "F3", have.Name())
+	})
+
+	t.Run("ignored", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TNaming{})
+
+		// --- When ---
+		have := md.FieldByPolicyName(TagPolicy("json"), "F5")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TNaming{})
+
+		// --- When ---
+		have := md.FieldByPolicyName(TagPolicy("json"), "abc")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
 func Test_Metadata_FieldByIndex(t *testing.T) {
 	t.Run("known exported field", func(t *testing.T) {
 		// --- Given ---
@@ -351,3 +719,47 @@ func Test_Metadata_FieldByIndex(t *testing.T) {
 		assert.Nil(t, have)
 	})
 }
+
+func Test_Metadata_Errors(t *testing.T) {
+	t.Run("valid tags", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(struct {
+			F0 int `json:"f0"`
+		}{})
+
+		// --- When ---
+		have := md.Errors()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("malformed tags", func(t *testing.T) {
+		// --- Given ---
+		typ := reflect.StructOf([]reflect.StructField{
+			{Name: "F0", Type: reflect.TypeOf(0), Tag: `json:f0`},
+			{Name: "F1", Type: reflect.TypeOf(0), Tag: `json:"f1"`},
+			{Name: "F2", Type: reflect.TypeOf(0), Tag: `json:"f2`},
+		})
+		md := NewTypeMetadata(typ)
+
+		// --- When ---
+		have := md.Errors()
+
+		// --- Then ---
+		assert.Len(t, 2, have)
+		assert.Same(t, md.fields[0].TagError(), have[0])
+		assert.Same(t, md.fields[2].TagError(), have[1])
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(42)
+
+		// --- When ---
+		have := md.Errors()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Method represents an exported method of a type.
+type Method struct {
+	name    string       // Method name.
+	typ     reflect.Type // Method type without the receiver.
+	ptrRecv bool         // Requires pointer receiver.
+}
+
+// Name returns the method name.
+func (m *Method) Name() string { return m.name }
+
+// Type returns the method function type without the receiver.
+func (m *Method) Type() reflect.Type { return m.typ }
+
+// IsPtrReceiver returns true if the method has a pointer receiver, in which
+// case it is not in the method set of the type values.
+func (m *Method) IsPtrReceiver() bool { return m.ptrRecv }
+
+// String returns the method signature, for example, "Get(string) (int,
+// error)".
+func (m *Method) String() string {
+	return m.name + strings.TrimPrefix(m.typ.String(), "func")
+}
+
+// getMethods returns exported methods of the type and the pointer to the
+// type, sorted by name.
+func getMethods(typ reflect.Type) []*Method {
+	if typ.Kind() == reflect.Interface {
+		var methods []*Method
+		for i := 0; i < typ.NumMethod(); i++ {
+			m := typ.Method(i)
+			if m.IsExported() {
+				methods = append(methods, &Method{name: m.Name, typ: m.Type})
+			}
+		}
+		return methods
+	}
+
+	ptr := reflect.PointerTo(typ)
+	if ptr.NumMethod() == 0 {
+		return nil
+	}
+	methods := make([]*Method, 0, ptr.NumMethod())
+	for i := 0; i < ptr.NumMethod(); i++ {
+		m := ptr.Method(i)
+		_, byValue := typ.MethodByName(m.Name)
+		methods = append(methods, &Method{
+			name:    m.Name,
+			typ:     methodType(m.Type),
+			ptrRecv: !byValue,
+		})
+	}
+	return methods
+}
+
+// methodType returns the method function type without the receiver.
+func methodType(typ reflect.Type) reflect.Type {
+	in := make([]reflect.Type, typ.NumIn()-1)
+	for i := range in {
+		in[i] = typ.In(i + 1)
+	}
+	out := make([]reflect.Type, typ.NumOut())
+	for i := range out {
+		out[i] = typ.Out(i)
+	}
+	return reflect.FuncOf(in, out, typ.IsVariadic())
+}
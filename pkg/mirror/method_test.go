@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TMethods is a type used in method tests.
+type TMethods struct {
+	Val int
+}
+
+func (m TMethods) Get() int { return m.Val }
+
+func (m *TMethods) Set(v int) { m.Val = v }
+
+func (m *TMethods) SetSmall(v uint8) { m.Val = int(v) }
+
+func (m *TMethods) Add(a, b int64) (int64, error) {
+	if a < 0 {
+		return 0, errors.New("negative")
+	}
+	return a + b, nil
+}
+
+func (m TMethods) Join(sep string, parts ...string) string {
+	return strings.Join(parts, sep)
+}
+
+func (m TMethods) Describe(v fmt.Stringer) string {
+	if v == nil {
+		return "nil"
+	}
+	return v.String()
+}
+
+func (m TMethods) Scale(f float64) float64 { return f * 2 }
+
+func (m TMethods) unexported() {} // nolint: unused
+
+func Test_Method_String(t *testing.T) {
+	// --- Given ---
+	md := NewMetadata(TMethods{})
+
+	// --- When ---
+	have := md.MethodByName("Add").String()
+
+	// --- Then ---
+	assert.Equal(t, "Add(int64, int64) (int64, error)", have)
+}
+
+func Test_getMethods(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		// --- When ---
+		have := getMethods(reflect.TypeOf(TMethods{}))
+
+		// --- Then ---
+		assert.Len(t, 7, have)
+		assert.Equal(t, "Add", have[0].Name())
+		assert.True(t, have[0].IsPtrReceiver())
+		assert.Equal(t, "Describe", have[1].Name())
+		assert.False(t, have[1].IsPtrReceiver())
+		assert.Equal(t, "Get", have[2].Name())
+		assert.False(t, have[2].IsPtrReceiver())
+		assert.Equal(t, reflect.TypeOf(func() int { return 0 }), have[2].Type())
+		assert.Equal(t, "Join", have[3].Name())
+		assert.True(t, have[3].Type().IsVariadic())
+		assert.Equal(t, "Scale", have[4].Name())
+		assert.Equal(t, "Set", have[5].Name())
+		assert.True(t, have[5].IsPtrReceiver())
+		assert.Equal(t, "SetSmall", have[6].Name())
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		// --- When ---
+		have := getMethods(reflect.TypeOf((*io.ReadCloser)(nil)).Elem())
+
+		// --- Then ---
+		assert.Len(t, 2, have)
+		assert.Equal(t, "Close() error", have[0].String())
+		assert.False(t, have[0].IsPtrReceiver())
+		assert.Equal(t, "Read([]uint8) (int, error)", have[1].String())
+	})
+
+	t.Run("no methods", func(t *testing.T) {
+		// --- When ---
+		have := getMethods(reflect.TypeOf(1))
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_methodType(t *testing.T) {
+	// --- Given ---
+	m, _ := reflect.TypeOf(&TMethods{}).MethodByName("Join")
+
+	// --- When ---
+	have := methodType(m.Type)
+
+	// --- Then ---
+	want := reflect.TypeOf(func(string, ...string) string { return "" })
+	assert.Equal(t, want, have)
+}
@@ -6,6 +6,7 @@ package mirror
 import (
 	"errors"
 	"reflect"
+	"runtime"
 	"sync"
 )
 
@@ -19,14 +20,66 @@ var (
 
 	// ErrUnexportedField represents error when accessing unexported field.
 	ErrUnexportedField = errors.New("unexported field")
+
+	// ErrFieldType represents error when a value cannot be assigned to
+	// a field because of its type.
+	ErrFieldType = errors.New("invalid field value type")
+
+	// ErrNoMethod represents error when a method does not exist.
+	ErrNoMethod = errors.New("method not found")
+
+	// ErrMethodCall represents error when a method cannot be called with
+	// given arguments.
+	ErrMethodCall = errors.New("invalid method call")
+
+	// ErrNoTagOption represents error when a tag option does not exist.
+	ErrNoTagOption = errors.New("tag option not found")
+
+	// ErrTagOptionValue represents error when a tag option value is invalid.
+	ErrTagOptionValue = errors.New("invalid tag option value")
+
+	// ErrValidation represents an error when a value fails validation.
+	ErrValidation = errors.New("validation error")
+
+	// ErrValidationRule represents an error in the validation rule definition.
+	ErrValidationRule = errors.New("invalid validation rule")
+
+	// ErrOverlay represents an error when values cannot be overlaid.
+	ErrOverlay = errors.New("overlay error")
+
+	// ErrNotStruct represents an error when a value is not a struct or
+	// a pointer to a struct.
+	ErrNotStruct = errors.New("not a struct")
+
+	// ErrNotAddressable represents an error when a value must be addressable
+	// to be accessed or modified.
+	ErrNotAddressable = errors.New("value not addressable")
 )
 
+// MirrorTag is the struct tag key with options for the functions in this
+// package, for example, `mirror:"shallow"`.
+const MirrorTag = "mirror"
+
 var (
 	typCache   map[reflect.Type]*Metadata // Type metadata cache.
 	typCacheMX sync.RWMutex               // Guards typCache.
+
+	fnCache   map[fnKey]*Metadata // Function metadata cache.
+	fnCacheMX sync.RWMutex        // Guards fnCache.
 )
 
-func init() { typCache = map[reflect.Type]*Metadata{} }
+// fnKey identifies function values in the function metadata cache. The same
+// function may be converted to different named function types, so the type
+// is a part of the key.
+type fnKey struct {
+	typ reflect.Type // Function type.
+	pc  uintptr      // Function pointer.
+}
+
+func init() {
+	typCache = map[reflect.Type]*Metadata{}
+	fnCache = map[fnKey]*Metadata{}
+}
 
 // Reflect extracts [Metadata] about type of "v".
 func Reflect(v any) *Metadata {
@@ -53,23 +106,33 @@ func ReflectType(typ reflect.Type) *Metadata {
 	return md
 }
 
-// ReflectValue extracts [Metadata] about the value.
+// ReflectValue extracts [Metadata] about the value. For function values the
+// function identity is cached by the type and the function pointer, while the
+// type level metadata is shared with all values of the same type.
 func ReflectValue(val reflect.Value) *Metadata {
-	typ := val.Type()
-	if typ.Kind() == reflect.Ptr {
-		typ = typ.Elem()
+	md := ReflectType(val.Type())
+	if md.kind != reflect.Func || val.Kind() != reflect.Func {
+		return md
+	}
+	key := fnKey{typ: val.Type(), pc: val.Pointer()}
+	if key.pc == 0 {
+		return md
 	}
 
-	typCacheMX.RLock()
-	md, found := typCache[typ]
-	typCacheMX.RUnlock()
+	fnCacheMX.RLock()
+	fmd, found := fnCache[key]
+	fnCacheMX.RUnlock()
 	if found {
-		return md
+		return fmd
 	}
 
-	md = NewValueMetadata(val)
-	typCacheMX.Lock()
-	typCache[typ] = md
-	typCacheMX.Unlock()
-	return md
+	fn := runtime.FuncForPC(key.pc)
+	if fn == nil {
+		return md
+	}
+	fmd = md.withFunc(fn)
+	fnCacheMX.Lock()
+	fnCache[key] = fmd
+	fnCacheMX.Unlock()
+	return fmd
 }
@@ -78,4 +78,89 @@ func Test_ReflectValue(t *testing.T) {
 		assert.Equal(t, "After", have.name)
 		assert.Equal(t, "github.com/ctx42/testing/pkg/check", have.pkg)
 	})
+
+	t.Run("funcs of the same type", func(t *testing.T) {
+		// --- Given ---
+		fa := func() {}
+		fb := func() {}
+
+		// --- When ---
+		haveA := ReflectValue(reflect.ValueOf(fa))
+		haveB := ReflectValue(reflect.ValueOf(fb))
+
+		// --- Then ---
+		assert.Equal(t, "Test_ReflectValue.func2.1", haveA.name)
+		assert.Equal(t, "Test_ReflectValue.func2.2", haveB.name)
+		assert.NotEqual(t, haveA.Func().Line(), haveB.Func().Line())
+		assert.Same(t, haveA, ReflectValue(reflect.ValueOf(fa)))
+		key := fnKey{typ: reflect.TypeOf(fa), pc: reflect.ValueOf(fa).Pointer()}
+		assert.Same(t, haveA, fnCache[key])
+	})
+
+	t.Run("type metadata stays shared", func(t *testing.T) {
+		// --- Given ---
+		typ := reflect.TypeOf(TFuncHandler)
+
+		// --- When ---
+		have := ReflectValue(reflect.ValueOf(TFuncHandler))
+
+		// --- Then ---
+		md := ReflectType(typ)
+		assert.Equal(t, "", md.name)
+		assert.Equal(t, "", md.Func().File())
+		assert.Equal(t, "TFuncHandler", have.name)
+		assert.Same(t, md.Func().In()[0], have.Func().In()[0])
+	})
+
+	t.Run("nil func", func(t *testing.T) {
+		// --- Given ---
+		var fn func(int) string
+
+		// --- When ---
+		have := ReflectValue(reflect.ValueOf(fn))
+
+		// --- Then ---
+		assert.Same(t, ReflectType(reflect.TypeOf(fn)), have)
+		assert.Equal(t, "", have.name)
+	})
+
+	t.Run("named func type", func(t *testing.T) {
+		// --- Given ---
+		type handler func()
+		fn := handler(func() {})
+
+		// --- When ---
+		have := ReflectValue(reflect.ValueOf(fn))
+
+		// --- Then ---
+		assert.Equal(t, "handler", have.name)
+		assert.True(t, have.Func().IsClosure())
+	})
+
+	t.Run("same func converted to named func type", func(t *testing.T) {
+		// --- Given ---
+		type handler func()
+		fn := func() {}
+		plain := ReflectValue(reflect.ValueOf(fn))
+
+		// --- When ---
+		have := ReflectValue(reflect.ValueOf(handler(fn)))
+
+		// --- Then ---
+		assert.Equal(t, reflect.TypeOf(handler(nil)), have.Type())
+		assert.Equal(t, "handler", have.name)
+		assert.Equal(t, reflect.TypeOf(fn), plain.Type())
+		assert.NotSame(t, plain, have)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf(&TStruct{})
+
+		// --- When ---
+		have := ReflectValue(val)
+
+		// --- Then ---
+		assert.Same(t, ReflectType(val.Type()), have)
+	})
 }
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NamingStrategy derives external names from Go field names.
+//
+// Names derived for [Field] with [Field.NameWith] are cached for strategies
+// of comparable types.
+type NamingStrategy interface {
+	// FieldName returns the external name for the Go field name.
+	FieldName(name string) string
+}
+
+// NamingFunc is an adapter allowing the use of ordinary functions as
+// [NamingStrategy]. Names derived by functions are not cached.
+type NamingFunc func(name string) string
+
+// FieldName returns the result of calling fn(name).
+func (fn NamingFunc) FieldName(name string) string { return fn(name) }
+
+// Built-in naming strategies. All of them treat runs of uppercase letters as
+// initialisms, for example, "HTTPServerURL" is split into "HTTP", "Server"
+// and "URL" words.
+var (
+	// SnakeCase converts "HTTPServerURL" to "http_server_url".
+	SnakeCase NamingStrategy = wordCase{sep: "_"}
+
+	// KebabCase converts "HTTPServerURL" to "http-server-url".
+	KebabCase NamingStrategy = wordCase{sep: "-"}
+
+	// CamelCase converts "HTTPServerURL" to "httpServerURL". The first word
+	// is lowercased, the case of initialisms in the following words is kept.
+	CamelCase NamingStrategy = wordCase{camel: true}
+
+	// ScreamingSnakeCase converts "HTTPServerURL" to "HTTP_SERVER_URL".
+	ScreamingSnakeCase NamingStrategy = wordCase{sep: "_", upper: true}
+
+	// LowerCase converts "HTTPServerURL" to "httpserverurl".
+	LowerCase NamingStrategy = wordCase{}
+)
+
+// wordCase is a naming strategy which splits names into words and joins them
+// back using the configured separator and letter case.
+type wordCase struct {
+	sep   string // Words separator.
+	upper bool   // Use uppercase words.
+	camel bool   // Use camelCase words.
+}
+
+func (wc wordCase) FieldName(name string) string {
+	words := splitWords(name)
+	for i, word := range words {
+		switch {
+		case wc.upper:
+			words[i] = strings.ToUpper(word)
+		case wc.camel && i > 0:
+			r, size := utf8.DecodeRuneInString(word)
+			words[i] = string(unicode.ToUpper(r)) + word[size:]
+		default:
+			words[i] = strings.ToLower(word)
+		}
+	}
+	return strings.Join(words, wc.sep)
+}
+
+// splitWords splits the name into words. Words are separated by characters
+// which are neither letters nor digits and by letter case changes. Runs of
+// uppercase letters are treated as initialisms, the plural "s" following an
+// initialism belongs to it ("IDs"). Digits belong to the preceding word.
+func splitWords(name string) []string {
+	rs := []rune(name)
+	var words []string
+	start := -1
+	for i, r := range rs {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(rs[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start >= 0 && isWordStart(rs, i) {
+			words = append(words, string(rs[start:i]))
+			start = i
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(rs[start:]))
+	}
+	return words
+}
+
+// isWordStart returns true when the rune at index "i" (greater than zero)
+// starts a new word.
+func isWordStart(rs []rune, i int) bool {
+	r, prev := rs[i], rs[i-1]
+	if !unicode.IsUpper(r) {
+		return false
+	}
+	if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+		return true
+	}
+	if !unicode.IsUpper(prev) || i+1 >= len(rs) || !unicode.IsLower(rs[i+1]) {
+		return false
+	}
+	// Plural initialism like "IDs" followed by the end or the next word.
+	if rs[i+1] == 's' && (i+2 == len(rs) || !unicode.IsLower(rs[i+2])) {
+		return false
+	}
+	return true
+}
+
+// NamingPolicy describes how external names are derived from struct fields.
+// The name is taken from the first tag with an explicitly set name, in the
+// order of keys. When none of the tags set the name, the fallback strategy is
+// applied to the Go field name.
+//
+// Example:
+//
+//	// Prefer "db", then "json", else snake_case Go name.
+//	policy := NamingPolicy{
+//		Keys:     []string{"db", "json"},
+//		Fallback: SnakeCase,
+//	}
+//
+// The zero value policy uses Go field names.
+type NamingPolicy struct {
+	Keys     []string       // Tag keys in the order of preference.
+	Fallback NamingStrategy // Derives name from Go field name.
+}
+
+// TagPolicy returns [NamingPolicy] using tag keys in the given order and Go
+// field names as a fallback.
+func TagPolicy(keys ...string) NamingPolicy {
+	return NamingPolicy{Keys: keys}
+}
+
+// Name returns the external name of the field.
+func (np NamingPolicy) Name(fld *Field) string {
+	if tag, ok := np.tag(fld); ok {
+		return tag.Name()
+	}
+	return fld.NameWith(np.Fallback)
+}
+
+// IsNamed returns true when one of the policy tags sets the field name
+// explicitly.
+func (np NamingPolicy) IsNamed(fld *Field) bool {
+	_, ok := np.tag(fld)
+	return ok
+}
+
+// IsIgnored returns true when the first existing policy tag has the "-" name.
+func (np NamingPolicy) IsIgnored(fld *Field) bool {
+	return fld.TagAny(np.Keys...).IsIgnored()
+}
+
+// tag returns the first tag with explicitly set name.
+func (np NamingPolicy) tag(fld *Field) (Tag, bool) {
+	for _, key := range np.Keys {
+		if tag := fld.Tag(key); tag.hasName() {
+			return tag, true
+		}
+	}
+	return Tag{}, false
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TNaming is a struct used in naming tests.
+type TNaming struct {
+	F0 string `db:"col0" json:"json0"`
+	F1 string `json:"json1"`
+	F2 string `json:",omitempty"`
+	F3 string
+	F4 string `db:"-" json:"json4"`
+	F5 string `json:"-"`
+}
+
+func Test_NamingFunc_FieldName(t *testing.T) {
+	// --- Given ---
+	fn := NamingFunc(func(name string) string { return "x" + name })
+
+	// --- When ---
+	have := fn.FieldName("Name")
+
+	// --- Then ---
+	assert.Equal(t, "xName", have)
+}
+
+func Test_NamingStrategy_builtin_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		ns   NamingStrategy
+		name string
+		want string
+	}{
+		{"snake initialisms", SnakeCase, "HTTPServerURL", "http_server_url"},
+		{"snake simple", SnakeCase, "UserName", "user_name"},
+		{"snake single", SnakeCase, "ID", "id"},
+		{"snake plural initialism", SnakeCase, "UserIDs", "user_ids"},
+		{"snake digits", SnakeCase, "Int64Value", "int64_value"},
+		{"snake lower first", SnakeCase, "userName", "user_name"},
+		{"snake separators", SnakeCase, "user_Name", "user_name"},
+		{"snake empty", SnakeCase, "", ""},
+		{"kebab", KebabCase, "HTTPServerURL", "http-server-url"},
+		{"camel", CamelCase, "HTTPServerURL", "httpServerURL"},
+		{"camel simple", CamelCase, "UserName", "userName"},
+		{"camel separators", CamelCase, "user_name", "userName"},
+		{"screaming", ScreamingSnakeCase, "HTTPServerURL", "HTTP_SERVER_URL"},
+		{"lower", LowerCase, "HTTPServerURL", "httpserverurl"},
+		{"non ascii", SnakeCase, "ŻółwŁódź", "żółw_łódź"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.ns.FieldName(tc.name)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_splitWords_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		name string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"single word", "Name", []string{"Name"}},
+		{"two words", "UserName", []string{"User", "Name"}},
+		{"initialism first", "HTTPServer", []string{"HTTP", "Server"}},
+		{"initialism last", "ServerURL", []string{"Server", "URL"}},
+		{"only initialism", "URL", []string{"URL"}},
+		{"plural initialism", "IDs", []string{"IDs"}},
+		{"plural initialism next", "IDsList", []string{"IDs", "List"}},
+		{"digits", "Base64Encode", []string{"Base64", "Encode"}},
+		{"separators", "_a__b-c ", []string{"a", "b", "c"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := splitWords(tc.name)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_TagPolicy(t *testing.T) {
+	// --- When ---
+	have := TagPolicy("db", "json")
+
+	// --- Then ---
+	assert.Equal(t, []string{"db", "json"}, have.Keys)
+	assert.Nil(t, have.Fallback)
+}
+
+func Test_NamingPolicy_Name_tabular(t *testing.T) {
+	np := NamingPolicy{
+		Keys:     []string{"db", "json"},
+		Fallback: SnakeCase,
+	}
+
+	tt := []struct {
+		testN string
+
+		np    NamingPolicy
+		field string
+		want  string
+	}{
+		{"first key", np, "F0", "col0"},
+		{"second key", np, "F1", "json1"},
+		{"name not set", np, "F2", "f2"},
+		{"no tags", np, "F3", "f3"},
+		{"ignored first key", np, "F4", "json4"},
+		{"ignored", np, "F5", "f5"},
+		{"no fallback", TagPolicy("json"), "F3", "F3"},
+		{"zero value", NamingPolicy{}, "F0", "F0"},
+		{"snake case", NamingPolicy{Fallback: SnakeCase}, "F3", "f3"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			fld := Reflect(TNaming{}).FieldByName(tc.field)
+
+			// --- When ---
+			have := tc.np.Name(fld)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_NamingPolicy_IsNamed_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		field string
+		want  bool
+	}{
+		{"first key", "F0", true},
+		{"second key", "F1", true},
+		{"name not set", "F2", false},
+		{"no tags", "F3", false},
+		{"ignored", "F5", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			fld := Reflect(TNaming{}).FieldByName(tc.field)
+
+			// --- When ---
+			have := TagPolicy("db", "json").IsNamed(fld)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_NamingPolicy_IsIgnored_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		field string
+		want  bool
+	}{
+		{"not ignored", "F0", false},
+		{"first key ignored", "F4", true},
+		{"second key ignored", "F5", true},
+		{"no tags", "F3", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			fld := Reflect(TNaming{}).FieldByName(tc.field)
+
+			// --- When ---
+			have := TagPolicy("db", "json").IsIgnored(fld)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,320 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// DefaultMergeTag is the default struct tag key with [Overlay] strategies.
+const DefaultMergeTag = "merge"
+
+// Overlay merge strategies.
+const (
+	// MergeReplace replaces the destination value with non-zero source value.
+	MergeReplace = "replace"
+
+	// MergeAppend appends source slice elements to the destination slice or
+	// copies source map entries to the destination map.
+	MergeAppend = "append"
+
+	// MergeUnion appends source slice elements not present in the destination
+	// slice or copies source map entries for keys missing in the destination
+	// map.
+	MergeUnion = "union"
+
+	// MergeKeep sets the destination value only when it is zero.
+	MergeKeep = "keep"
+)
+
+// overlayChecks caches results of merge strategy checks by [overlayKey].
+var overlayChecks sync.Map
+
+// overlayKey identifies the merge strategy check for the struct type.
+type overlayKey struct {
+	typ    reflect.Type // Struct type.
+	tagKey string       // Struct tag key with merge strategies.
+}
+
+// OverlayOption represents [Overlay] option.
+type OverlayOption func(*overlayOpts)
+
+// WithMergeTag sets the struct tag key with merge strategies. By default, it
+// is the "merge" key.
+func WithMergeTag(key string) OverlayOption {
+	return func(opts *overlayOpts) { opts.tagKey = key }
+}
+
+// WithPtrToZero makes non-nil source pointers to zero values overwrite the
+// destination. By default, such pointers are treated as not set.
+func WithPtrToZero() OverlayOption {
+	return func(opts *overlayOpts) { opts.ptrToZero = true }
+}
+
+// overlayOpts represents [Overlay] options.
+type overlayOpts struct {
+	tagKey    string // Struct tag key with merge strategies.
+	ptrToZero bool   // Pointers to zero values are set.
+}
+
+// Overlay copies non-zero exported fields of "src" struct to "dst" struct.
+// The "dst" must be a non-nil pointer to a struct and "src" must be a struct
+// of the same type or a pointer to it.
+//
+// Nested structs and pointers to structs are overlaid recursively, except
+// structs without exported fields or with the "Equal" method, for example,
+// [time.Time], which are treated as single values. Other non-zero values
+// replace destination values, pointers to zero values are
+// treated as not set unless the [WithPtrToZero] option is used. Values are
+// copied using [Clone], so "dst" never shares memory with "src".
+//
+// Strategy for a field may be selected with the "merge" tag:
+//
+//	Hosts  []string          `merge:"append"`  // Append src elements.
+//	Tags   []string          `merge:"union"`   // Append new src elements.
+//	Labels map[string]string `merge:"append"`  // Copy src entries.
+//	Extra  map[string]string `merge:"union"`   // Copy entries for new keys.
+//	Name   string            `merge:"keep"`    // Set only when dst is zero.
+//	Opts   []string          `merge:"replace"` // The default.
+//	Secret string            `merge:"-"`       // Never overlaid.
+//
+// It returns an error wrapping [ErrOverlay] when arguments are invalid or
+// the merge strategy is not known or can't be used for the field type.
+// Strategies are checked for all fields before "dst" is changed.
+func Overlay(dst, src any, opts ...OverlayOption) error {
+	ops := &overlayOpts{tagKey: DefaultMergeTag}
+	for _, opt := range opts {
+		opt(ops)
+	}
+
+	dsv := NewStructValue(dst)
+	if dsv == nil {
+		return fmt.Errorf(
+			"%w: expected non-nil pointer to struct got %T",
+			ErrOverlay,
+			dst,
+		)
+	}
+	sv := reflect.Indirect(reflect.ValueOf(src))
+	if !sv.IsValid() || sv.Type() != dsv.Type() {
+		return fmt.Errorf(
+			"%w: expected %s got %T",
+			ErrOverlay,
+			dsv.Type(),
+			src,
+		)
+	}
+	if err := checkOverlay(dsv.Type(), ops.tagKey); err != nil {
+		return err
+	}
+	return ops.overlay(dsv, sv, "")
+}
+
+// checkOverlay returns an error if any field of the struct type, or of
+// nested structs overlaid recursively, has an unknown merge strategy or one
+// which can't be used for the field type. Results are cached.
+func checkOverlay(typ reflect.Type, tagKey string) error {
+	key := overlayKey{typ: typ, tagKey: tagKey}
+	if err, ok := overlayChecks.Load(key); ok {
+		if err == nil {
+			return nil
+		}
+		return err.(error)
+	}
+	err := checkStrategies(typ, tagKey, "", make(map[reflect.Type]bool))
+	overlayChecks.Store(key, err)
+	return err
+}
+
+// checkStrategies checks merge strategies of the struct type fields. See
+// [checkOverlay] for details.
+func checkStrategies(
+	typ reflect.Type,
+	tagKey, path string,
+	seen map[reflect.Type]bool,
+) error {
+
+	if seen[typ] {
+		return nil
+	}
+	seen[typ] = true
+	for _, fld := range ReflectType(typ).Fields() {
+		if !fld.IsExported() {
+			continue
+		}
+		tag := fld.Tag(tagKey)
+		if tag.IsIgnored() {
+			continue
+		}
+		fPath := joinPath(path, fld.Name())
+		var strategy string
+		if tag.hasName() {
+			strategy = tag.Name()
+		}
+		switch strategy {
+		case "":
+			if isMergeStruct(fld.IndirectType()) {
+				err := checkStrategies(fld.IndirectType(), tagKey, fPath, seen)
+				if err != nil {
+					return err
+				}
+			}
+
+		case MergeReplace, MergeKeep:
+
+		case MergeAppend, MergeUnion:
+			if kind := fld.Kind(); kind != reflect.Slice &&
+				kind != reflect.Map {
+
+				return fmt.Errorf(
+					"%w: %s: strategy %q on %s",
+					ErrOverlay,
+					fPath,
+					strategy,
+					fld.Type(),
+				)
+			}
+
+		default:
+			return fmt.Errorf(
+				"%w: %s: unknown strategy %q",
+				ErrOverlay,
+				fPath,
+				strategy,
+			)
+		}
+	}
+	return nil
+}
+
+// overlay overlays source struct fields on the destination struct.
+func (opts *overlayOpts) overlay(
+	dsv *StructValue,
+	src reflect.Value,
+	path string,
+) error {
+
+	for i := 0; i < dsv.NumField(); i++ {
+		fv := dsv.FieldByIndex(i)
+		if !fv.IsExported() {
+			continue
+		}
+		tag := fv.Tag(opts.tagKey)
+		if tag.IsIgnored() {
+			continue
+		}
+		var strategy string
+		if tag.hasName() {
+			strategy = tag.Name()
+		}
+		fPath := joinPath(path, fv.Name())
+		err := opts.field(fv, src.Field(i), strategy, fPath)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// field overlays the source value on the destination field using the merge
+// strategy.
+//
+// nolint: cyclop
+func (opts *overlayOpts) field(
+	fv *FieldValue,
+	src reflect.Value,
+	strategy, path string,
+) error {
+
+	if !opts.isSet(src) {
+		return nil
+	}
+	dst := fv.Value()
+
+	switch strategy {
+	case "":
+		if fv.Kind() == reflect.Struct && isMergeStruct(fv.Type()) {
+			return opts.overlay(fv.StructValue(), src, path)
+		}
+		if fv.Kind() == reflect.Ptr && isMergeStruct(fv.IndirectType()) &&
+			!dst.IsNil() {
+
+			return opts.overlay(fv.StructValue(), src.Elem(), path)
+		}
+		dst.Set(cloneValue(src))
+
+	case MergeReplace:
+		dst.Set(cloneValue(src))
+
+	case MergeKeep:
+		if dst.IsZero() {
+			dst.Set(cloneValue(src))
+		}
+
+	case MergeAppend, MergeUnion:
+		union := strategy == MergeUnion
+		switch fv.Kind() {
+		case reflect.Slice:
+			for i := 0; i < src.Len(); i++ {
+				elem := src.Index(i)
+				if union && containsValue(dst, elem) {
+					continue
+				}
+				dst.Set(reflect.Append(dst, cloneValue(elem)))
+			}
+
+		case reflect.Map:
+			fv.NewIfNil()
+			iter := src.MapRange()
+			for iter.Next() {
+				if union && dst.MapIndex(iter.Key()).IsValid() {
+					continue
+				}
+				dst.SetMapIndex(iter.Key(), cloneValue(iter.Value()))
+			}
+
+		default:
+			return fmt.Errorf(
+				"%w: %s: strategy %q on %s",
+				ErrOverlay,
+				path,
+				strategy,
+				fv.Type(),
+			)
+		}
+
+	default:
+		return fmt.Errorf(
+			"%w: %s: unknown strategy %q",
+			ErrOverlay,
+			path,
+			strategy,
+		)
+	}
+	return nil
+}
+
+// isSet returns true if the source value should be overlaid.
+func (opts *overlayOpts) isSet(src reflect.Value) bool {
+	if src.Kind() == reflect.Ptr && !src.IsNil() {
+		return opts.ptrToZero || !src.Elem().IsZero()
+	}
+	if src.Kind() == reflect.Slice || src.Kind() == reflect.Map {
+		return src.Len() > 0
+	}
+	return !src.IsZero()
+}
+
+// containsValue returns true if the slice contains the value using [Equal]
+// rules.
+func containsValue(slice, val reflect.Value) bool {
+	for i := 0; i < slice.Len(); i++ {
+		if equalValues(slice.Index(i), val) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,495 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TOverlay is a struct used in overlay tests.
+type TOverlay struct {
+	Name   string
+	Port   int
+	Debug  *bool
+	Hosts  []string
+	Labels map[string]string
+	DB     TOverlayDB
+	Cache  *TOverlayDB
+	priv   int
+}
+
+// TOverlayDB is a struct used in overlay tests.
+type TOverlayDB struct {
+	DSN  string
+	Pool int
+}
+
+// TOverlayStrategy is a struct used in overlay tests.
+type TOverlayStrategy struct {
+	Replace   []string          `merge:"replace"`
+	Append    []string          `merge:"append"`
+	Union     []string          `merge:"union"`
+	MapAppend map[string]string `merge:"append"`
+	MapUnion  map[string]string `merge:"union"`
+	Keep      string            `merge:"keep"`
+	Skip      string            `merge:"-"`
+	Option    string            `merge:",omitempty"`
+	DB        TOverlayDB        `merge:"replace"`
+}
+
+// TOverlayNode is a recursive struct used in overlay tests.
+type TOverlayNode struct {
+	Val  int
+	Next *TOverlayNode
+}
+
+func Test_Overlay(t *testing.T) {
+	t.Run("non-zero fields", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{Name: "default", Port: 80, Hosts: []string{"a"}}
+		src := TOverlay{Port: 8080}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := &TOverlay{Name: "default", Port: 8080, Hosts: []string{"a"}}
+		assert.Equal(t, want, dst)
+	})
+
+	t.Run("source pointer", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{Name: "default"}
+		src := &TOverlay{Name: "override"}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "override", dst.Name)
+	})
+
+	t.Run("nested struct", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{DB: TOverlayDB{DSN: "dsn", Pool: 1}}
+		src := TOverlay{DB: TOverlayDB{Pool: 10}}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, TOverlayDB{DSN: "dsn", Pool: 10}, dst.DB)
+	})
+
+	t.Run("nested pointer to struct", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{Cache: &TOverlayDB{DSN: "dsn", Pool: 1}}
+		src := TOverlay{Cache: &TOverlayDB{Pool: 10}}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, &TOverlayDB{DSN: "dsn", Pool: 10}, dst.Cache)
+	})
+
+	t.Run("nil destination pointer to struct", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{}
+		src := TOverlay{Cache: &TOverlayDB{Pool: 10}}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, &TOverlayDB{Pool: 10}, dst.Cache)
+		assert.NotSame(t, src.Cache, dst.Cache)
+	})
+
+	t.Run("time fields are replaced", func(t *testing.T) {
+		// --- Given ---
+		type Cfg struct {
+			At time.Time
+			T  *time.Time
+		}
+		t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		t1 := t0.Add(time.Hour)
+		dst := &Cfg{At: t0, T: ptr(t0)}
+
+		// --- When ---
+		err := Overlay(dst, Cfg{At: t1, T: &t1})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, t1, dst.At)
+		assert.Equal(t, t1, *dst.T)
+		assert.NotSame(t, &t1, dst.T)
+	})
+
+	t.Run("zero time is not set", func(t *testing.T) {
+		// --- Given ---
+		type Cfg struct{ At time.Time }
+		t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		dst := &Cfg{At: t0}
+
+		// --- When ---
+		err := Overlay(dst, Cfg{})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, t0, dst.At)
+	})
+
+	t.Run("struct with equal method is replaced", func(t *testing.T) {
+		// --- Given ---
+		type Cfg struct{ V TMergeVersion }
+		dst := &Cfg{V: TMergeVersion{Major: 1, Minor: 1}}
+
+		// --- When ---
+		err := Overlay(dst, Cfg{V: TMergeVersion{Major: 2}})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, TMergeVersion{Major: 2}, dst.V)
+	})
+
+	t.Run("values are cloned", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{}
+		src := TOverlay{Hosts: []string{"a"}}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		src.Hosts[0] = "b"
+		assert.Equal(t, []string{"a"}, dst.Hosts)
+	})
+
+	t.Run("empty slices and maps are not set", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{
+			Hosts:  []string{"a"},
+			Labels: map[string]string{"a": "1"},
+		}
+		src := TOverlay{Hosts: []string{}, Labels: map[string]string{}}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a"}, dst.Hosts)
+		assert.Equal(t, map[string]string{"a": "1"}, dst.Labels)
+	})
+
+	t.Run("pointer to zero is not set", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{Debug: Ptr(true)}
+		src := TOverlay{Debug: Ptr(false)}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, *dst.Debug)
+	})
+
+	t.Run("pointer to zero with option", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{Debug: Ptr(true)}
+		src := TOverlay{Debug: Ptr(false)}
+
+		// --- When ---
+		err := Overlay(dst, src, WithPtrToZero())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, *dst.Debug)
+		assert.NotSame(t, src.Debug, dst.Debug)
+	})
+
+	t.Run("unexported fields are skipped", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlay{priv: 1}
+		src := TOverlay{priv: 2}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, dst.priv)
+	})
+
+	t.Run("strategies", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlayStrategy{
+			Replace:   []string{"a", "b"},
+			Append:    []string{"a", "b"},
+			Union:     []string{"a", "b"},
+			MapAppend: map[string]string{"a": "1", "b": "1"},
+			MapUnion:  map[string]string{"a": "1", "b": "1"},
+			Keep:      "dst",
+			Skip:      "dst",
+			Option:    "dst",
+			DB:        TOverlayDB{DSN: "dsn", Pool: 1},
+		}
+		src := TOverlayStrategy{
+			Replace:   []string{"b", "c"},
+			Append:    []string{"b", "c"},
+			Union:     []string{"b", "c"},
+			MapAppend: map[string]string{"b": "2", "c": "2"},
+			MapUnion:  map[string]string{"b": "2", "c": "2"},
+			Keep:      "src",
+			Skip:      "src",
+			Option:    "src",
+			DB:        TOverlayDB{Pool: 10},
+		}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := &TOverlayStrategy{
+			Replace:   []string{"b", "c"},
+			Append:    []string{"a", "b", "b", "c"},
+			Union:     []string{"a", "b", "c"},
+			MapAppend: map[string]string{"a": "1", "b": "2", "c": "2"},
+			MapUnion:  map[string]string{"a": "1", "b": "1", "c": "2"},
+			Keep:      "dst",
+			Skip:      "dst",
+			Option:    "src",
+			DB:        TOverlayDB{Pool: 10},
+		}
+		assert.Equal(t, want, dst)
+	})
+
+	t.Run("strategies with zero destination", func(t *testing.T) {
+		// --- Given ---
+		dst := &TOverlayStrategy{}
+		src := TOverlayStrategy{
+			Append:   []string{"a"},
+			MapUnion: map[string]string{"a": "1"},
+			Keep:     "src",
+		}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := &TOverlayStrategy{
+			Append:   []string{"a"},
+			MapUnion: map[string]string{"a": "1"},
+			Keep:     "src",
+		}
+		assert.Equal(t, want, dst)
+	})
+
+	t.Run("custom tag", func(t *testing.T) {
+		// --- Given ---
+		dst := &struct {
+			A []string `ovr:"append"`
+		}{A: []string{"a"}}
+		src := struct {
+			A []string `ovr:"append"`
+		}{A: []string{"b"}}
+
+		// --- When ---
+		err := Overlay(dst, src, WithMergeTag("ovr"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, dst.A)
+	})
+
+	t.Run("error - unknown strategy", func(t *testing.T) {
+		// --- Given ---
+		dst := &struct {
+			A string `merge:"abc"`
+		}{}
+		src := struct {
+			A string `merge:"abc"`
+		}{A: "a"}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOverlay, err)
+		assert.ErrorEqual(t, `overlay error: A: unknown strategy "abc"`, err)
+	})
+
+	t.Run("error - strategy for invalid type", func(t *testing.T) {
+		// --- Given ---
+		dst := &struct {
+			A string `merge:"append"`
+		}{}
+		src := struct {
+			A string `merge:"append"`
+		}{A: "a"}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOverlay, err)
+		wMsg := `overlay error: A: strategy "append" on string`
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("error - nested field", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			A string `merge:"abc"`
+		}
+		dst := &struct{ T T }{}
+		src := struct{ T T }{T: T{A: "a"}}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.ErrorEqual(t, `overlay error: T.A: unknown strategy "abc"`, err)
+	})
+
+	t.Run("error - destination is not changed", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Name string
+			Tags []string
+			A    string `merge:"append"`
+		}
+		dst := &T{Name: "old"}
+		src := T{Name: "new", Tags: []string{"a"}, A: "a"}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOverlay, err)
+		assert.Equal(t, &T{Name: "old"}, dst)
+	})
+
+	t.Run("error - strategy of not set field", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Name string
+			A    string `merge:"abc"`
+		}
+		dst := &T{}
+		src := T{Name: "new"}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.ErrorEqual(t, `overlay error: A: unknown strategy "abc"`, err)
+		assert.Equal(t, "", dst.Name)
+	})
+
+	t.Run("recursive type", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			Name string
+			Next *TOverlayNode
+		}
+		dst := &T{Next: &TOverlayNode{}}
+		src := T{Name: "a", Next: &TOverlayNode{Val: 1}}
+
+		// --- When ---
+		err := Overlay(dst, src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "a", dst.Name)
+		assert.Equal(t, 1, dst.Next.Val)
+	})
+
+	t.Run("error - destination not a pointer", func(t *testing.T) {
+		// --- When ---
+		err := Overlay(TOverlay{}, TOverlay{})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOverlay, err)
+		wMsg := "overlay error: expected non-nil pointer to struct " +
+			"got mirror.TOverlay"
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("error - nil destination", func(t *testing.T) {
+		// --- When ---
+		err := Overlay((*TOverlay)(nil), TOverlay{})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOverlay, err)
+	})
+
+	t.Run("error - different types", func(t *testing.T) {
+		// --- When ---
+		err := Overlay(&TOverlay{}, TOverlayDB{})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOverlay, err)
+		wMsg := "overlay error: expected mirror.TOverlay " +
+			"got mirror.TOverlayDB"
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("error - nil source", func(t *testing.T) {
+		// --- When ---
+		err := Overlay(&TOverlay{}, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOverlay, err)
+	})
+}
+
+func Test_checkOverlay(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		typ := reflect.TypeOf(TOverlayNode{})
+
+		// --- When ---
+		err := checkOverlay(typ, DefaultMergeTag)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		key := overlayKey{typ: typ, tagKey: DefaultMergeTag}
+		cached, ok := overlayChecks.Load(key)
+		assert.True(t, ok)
+		assert.Nil(t, cached)
+	})
+
+	t.Run("error is cached", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			A string `merge:"abc"`
+		}
+		typ := reflect.TypeOf(T{})
+
+		// --- When ---
+		err := checkOverlay(typ, DefaultMergeTag)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOverlay, err)
+		key := overlayKey{typ: typ, tagKey: DefaultMergeTag}
+		cached, ok := overlayChecks.Load(key)
+		assert.True(t, ok)
+		assert.Same(t, err, cached.(error))
+	})
+}
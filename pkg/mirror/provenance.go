@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+// Origin represents the origin of a field value.
+type Origin struct {
+	Path   string // Field path using Go field names.
+	Source string // Source name, for example, "env" or "flags".
+	Raw    string // Raw input the value was created from.
+}
+
+// Provenance records where values of struct fields came from. It is useful
+// when a value is assembled from multiple sources, like defaults, files,
+// environment variables and command line flags.
+//
+// The recorder is passed to [FieldValue.Set] with the [WithProvenance]
+// option. Custom setters may use the [Provenance.Record] method directly.
+// When a field is set multiple times, the last origin is kept.
+//
+// The zero value is ready to use. It is safe for concurrent use.
+type Provenance struct {
+	origins map[string]Origin // Origins by field path.
+	mx      sync.RWMutex      // Guards origins.
+}
+
+// NewProvenance returns new instance of [Provenance].
+func NewProvenance() *Provenance {
+	return &Provenance{origins: make(map[string]Origin)}
+}
+
+// Record records the source name and the raw input for the field path.
+func (p *Provenance) Record(path, source, raw string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	if p.origins == nil {
+		p.origins = make(map[string]Origin)
+	}
+	p.origins[path] = Origin{Path: path, Source: source, Raw: raw}
+}
+
+// Lookup returns the origin of the field path and true if it was recorded.
+func (p *Provenance) Lookup(path string) (Origin, bool) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+	o, ok := p.origins[path]
+	return o, ok
+}
+
+// Origins returns all recorded origins sorted by path.
+func (p *Provenance) Origins() []Origin {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+	if len(p.origins) == 0 {
+		return nil
+	}
+	origins := make([]Origin, 0, len(p.origins))
+	for _, o := range p.origins {
+		origins = append(origins, o)
+	}
+	slices.SortFunc(origins, func(a, b Origin) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return origins
+}
+
+// Report writes the table with recorded origins sorted by path.
+//
+// Example:
+//
+//	PATH     SOURCE  RAW
+//	DB.Host  env     db.local
+//	Port     flags   8080
+func (p *Provenance) Report(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "PATH\tSOURCE\tRAW"); err != nil {
+		return err
+	}
+	for _, o := range p.Origins() {
+		_, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", o.Path, o.Source, o.Raw)
+		if err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// String returns the report table.
+func (p *Provenance) String() string {
+	buf := &strings.Builder{}
+	_ = p.Report(buf)
+	return buf.String()
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_NewProvenance(t *testing.T) {
+	// --- When ---
+	have := NewProvenance()
+
+	// --- Then ---
+	assert.NotNil(t, have.origins)
+	assert.Empty(t, have.origins)
+}
+
+func Test_Provenance_Record(t *testing.T) {
+	t.Run("record", func(t *testing.T) {
+		// --- Given ---
+		p := NewProvenance()
+
+		// --- When ---
+		p.Record("A.B", "env", "APP_B=1")
+
+		// --- Then ---
+		want := map[string]Origin{
+			"A.B": {Path: "A.B", Source: "env", Raw: "APP_B=1"},
+		}
+		assert.Equal(t, want, p.origins)
+	})
+
+	t.Run("last record wins", func(t *testing.T) {
+		// --- Given ---
+		p := NewProvenance()
+		p.Record("A", "defaults", "1")
+
+		// --- When ---
+		p.Record("A", "flags", "2")
+
+		// --- Then ---
+		want := map[string]Origin{
+			"A": {Path: "A", Source: "flags", Raw: "2"},
+		}
+		assert.Equal(t, want, p.origins)
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		// --- Given ---
+		p := &Provenance{}
+
+		// --- When ---
+		p.Record("A", "env", "1")
+
+		// --- Then ---
+		have, ok := p.Lookup("A")
+		assert.True(t, ok)
+		assert.Equal(t, "env", have.Source)
+	})
+}
+
+func Test_Provenance_Lookup(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		p := NewProvenance()
+		p.Record("A", "env", "1")
+
+		// --- When ---
+		have, ok := p.Lookup("A")
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, Origin{Path: "A", Source: "env", Raw: "1"}, have)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// --- Given ---
+		p := NewProvenance()
+
+		// --- When ---
+		have, ok := p.Lookup("A")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Zero(t, have)
+	})
+}
+
+func Test_Provenance_Origins(t *testing.T) {
+	t.Run("sorted by path", func(t *testing.T) {
+		// --- Given ---
+		p := NewProvenance()
+		p.Record("B", "env", "2")
+		p.Record("A.C", "file", "3")
+		p.Record("A", "flags", "1")
+
+		// --- When ---
+		have := p.Origins()
+
+		// --- Then ---
+		want := []Origin{
+			{Path: "A", Source: "flags", Raw: "1"},
+			{Path: "A.C", Source: "file", Raw: "3"},
+			{Path: "B", Source: "env", Raw: "2"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		p := NewProvenance()
+
+		// --- When ---
+		have := p.Origins()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Provenance_Report(t *testing.T) {
+	t.Run("report", func(t *testing.T) {
+		// --- Given ---
+		p := NewProvenance()
+		p.Record("Port", "flags", "8080")
+		p.Record("DB.Host", "env", "db.local")
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := p.Report(buf)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := "" +
+			"PATH     SOURCE  RAW\n" +
+			"DB.Host  env     db.local\n" +
+			"Port     flags   8080\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		p := NewProvenance()
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := p.Report(buf)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "PATH  SOURCE  RAW\n", buf.String())
+	})
+}
+
+func Test_Provenance_String(t *testing.T) {
+	// --- Given ---
+	p := NewProvenance()
+	p.Record("A", "env", "1")
+
+	// --- When ---
+	have := p.String()
+
+	// --- Then ---
+	assert.Equal(t, "PATH  SOURCE  RAW\nA     env     1\n", have)
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// Constants used when estimating map sizes.
+const (
+	mapHeaderSize = 48 // Approximate size of the map header.
+	mapGroupSlots = 8  // Number of slots in a map group.
+)
+
+// SizeOption represents [SizeOf] option.
+type SizeOption func(*sizeOpts)
+
+// WithSizeDepth limits the number of followed references (pointers,
+// strings, slices, maps, channels and interfaces). With depth zero, only the
+// size of the value itself is returned.
+func WithSizeDepth(depth int) SizeOption {
+	return func(opts *sizeOpts) { opts.depth = depth }
+}
+
+// WithSkipNoSize makes [SizeOf] skip memory referenced by struct fields
+// tagged with `mirror:"nosize"`. The tag has no effect without this option.
+// The size of the field itself is still counted as it is part of the struct.
+func WithSkipNoSize() SizeOption {
+	return func(opts *sizeOpts) { opts.noSize = true }
+}
+
+// sizeOpts represents [SizeOf] options.
+type sizeOpts struct {
+	depth  int  // Maximum depth of followed references, -1 for no limit.
+	noSize bool // Skip fields tagged with `mirror:"nosize"`.
+}
+
+// SizeOf returns the approximate number of bytes used by "v" and memory it
+// references. It accounts for struct sizes, backing arrays of strings and
+// slices, channel buffers, values stored in interfaces and approximate map
+// storage. Memory referenced more than once, for example, by aliasing
+// pointers or slices, is counted once, which also makes it safe to use with
+// cyclic structures. Functions are counted as pointers.
+//
+// Memory referenced by fields tagged with `mirror:"nosize"` is counted too,
+// unless the [WithSkipNoSize] option is used.
+func SizeOf(v any, opts ...SizeOption) int64 {
+	val := reflect.ValueOf(v)
+	if !val.IsValid() {
+		return 0
+	}
+	ops := &sizeOpts{depth: -1}
+	for _, opt := range opts {
+		opt(ops)
+	}
+	sz := &sizer{opts: ops, seen: make(map[sizeKey]bool)}
+	return int64(val.Type().Size()) + sz.refs(val, 0)
+}
+
+// sizeKey identifies already counted memory.
+type sizeKey struct {
+	typ reflect.Type   // Value type.
+	ptr unsafe.Pointer // Pointer to the memory.
+}
+
+// sizer keeps the state of a single [SizeOf] call.
+type sizer struct {
+	opts *sizeOpts        // Options.
+	seen map[sizeKey]bool // Already counted memory.
+}
+
+// refs returns the number of bytes referenced by the value, not including
+// the size of the value itself.
+func (sz *sizer) refs(val reflect.Value, depth int) int64 {
+	if sz.opts.depth >= 0 && depth >= sz.opts.depth {
+		if val.Kind() != reflect.Struct && val.Kind() != reflect.Array {
+			return 0
+		}
+	}
+	switch val.Kind() {
+	case reflect.Ptr:
+		if val.IsNil() || !sz.first(val.Type(), val.UnsafePointer()) {
+			return 0
+		}
+		elem := val.Elem()
+		return int64(elem.Type().Size()) + sz.refs(elem, depth+1)
+
+	case reflect.String:
+		if val.Len() == 0 {
+			return 0
+		}
+		ptr := unsafe.Pointer(unsafe.StringData(val.String()))
+		if !sz.first(val.Type(), ptr) {
+			return 0
+		}
+		return int64(val.Len())
+
+	case reflect.Slice:
+		if val.IsNil() || !sz.first(val.Type(), val.UnsafePointer()) {
+			return 0
+		}
+		n := int64(val.Cap()) * int64(val.Type().Elem().Size())
+		for i := 0; i < val.Len(); i++ {
+			n += sz.refs(val.Index(i), depth+1)
+		}
+		return n
+
+	case reflect.Array:
+		var n int64
+		for i := 0; i < val.Len(); i++ {
+			n += sz.refs(val.Index(i), depth)
+		}
+		return n
+
+	case reflect.Map:
+		if val.IsNil() || !sz.first(val.Type(), val.UnsafePointer()) {
+			return 0
+		}
+		n := mapSize(val.Type(), val.Len())
+		iter := val.MapRange()
+		for iter.Next() {
+			n += sz.refs(iter.Key(), depth+1)
+			n += sz.refs(iter.Value(), depth+1)
+		}
+		return n
+
+	case reflect.Chan:
+		if val.IsNil() || !sz.first(val.Type(), val.UnsafePointer()) {
+			return 0
+		}
+		return int64(val.Cap()) * int64(val.Type().Elem().Size())
+
+	case reflect.Interface:
+		if val.IsNil() {
+			return 0
+		}
+		elem := val.Elem()
+		switch elem.Kind() {
+		case reflect.Ptr, reflect.Map, reflect.Chan, reflect.Func,
+			reflect.UnsafePointer:
+			// Pointer shaped values are stored directly in the interface.
+			return sz.refs(elem, depth)
+		default:
+			return int64(elem.Type().Size()) + sz.refs(elem, depth+1)
+		}
+
+	case reflect.Struct:
+		var n int64
+		for i, fld := range ReflectType(val.Type()).Fields() {
+			tag := fld.Tag(MirrorTag)
+			if sz.opts.noSize &&
+				(tag.Name() == "nosize" || tag.Contains("nosize")) {
+				continue
+			}
+			n += sz.refs(val.Field(i), depth)
+		}
+		return n
+
+	default:
+		return 0
+	}
+}
+
+// first marks the memory as counted and returns true if it was not counted
+// before.
+func (sz *sizer) first(typ reflect.Type, ptr unsafe.Pointer) bool {
+	key := sizeKey{typ: typ, ptr: ptr}
+	if sz.seen[key] {
+		return false
+	}
+	sz.seen[key] = true
+	return true
+}
+
+// mapSize returns the approximate size of the map storage with "n" entries.
+// Each slot has a control byte, a key and a value; slots are allocated in
+// groups and kept at most 7/8 full.
+func mapSize(typ reflect.Type, n int) int64 {
+	slots := mapGroupSlots
+	for slots*7/8 < n {
+		slots *= 2
+	}
+	slot := 1 + typ.Key().Size() + typ.Elem().Size()
+	return mapHeaderSize + int64(slots)*int64(slot)
+}
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TSize is a type used in size estimation tests.
+type TSize struct {
+	Name  string
+	Data  []byte `mirror:"nosize"`
+	Next  *TSize
+	Attrs map[string]int
+}
+
+func Test_SizeOf(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := SizeOf(nil)
+
+		// --- Then ---
+		assert.Equal(t, int64(0), have)
+	})
+
+	t.Run("int", func(t *testing.T) {
+		// --- When ---
+		have := SizeOf(42)
+
+		// --- Then ---
+		assert.Equal(t, int64(8), have)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have := SizeOf("abc")
+
+		// --- Then ---
+		assert.Equal(t, int64(16+3), have)
+	})
+
+	t.Run("slice uses capacity", func(t *testing.T) {
+		// --- Given ---
+		s := make([]int32, 2, 10)
+
+		// --- When ---
+		have := SizeOf(s)
+
+		// --- Then ---
+		assert.Equal(t, int64(24+40), have)
+	})
+
+	t.Run("slice of strings", func(t *testing.T) {
+		// --- Given ---
+		s := []string{"ab", "cde"}
+
+		// --- When ---
+		have := SizeOf(s)
+
+		// --- Then ---
+		assert.Equal(t, int64(24+2*16+2+3), have)
+	})
+
+	t.Run("pointer to struct", func(t *testing.T) {
+		// --- Given ---
+		s := &TSize{Name: "abc"}
+
+		// --- When ---
+		have := SizeOf(s)
+
+		// --- Then ---
+		size := int64(reflect.TypeOf(TSize{}).Size())
+		assert.Equal(t, 8+size+3, have)
+	})
+
+	t.Run("aliased pointers counted once", func(t *testing.T) {
+		// --- Given ---
+		n := &TSize{}
+		s := []*TSize{n, n}
+
+		// --- When ---
+		have := SizeOf(s)
+
+		// --- Then ---
+		size := int64(reflect.TypeOf(TSize{}).Size())
+		assert.Equal(t, 24+2*8+size, have)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// --- Given ---
+		s := &TSize{}
+		s.Next = s
+
+		// --- When ---
+		have := SizeOf(s)
+
+		// --- Then ---
+		size := int64(reflect.TypeOf(TSize{}).Size())
+		assert.Equal(t, 8+size, have)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]int{"a": 1, "bc": 2}
+
+		// --- When ---
+		have := SizeOf(m)
+
+		// --- Then ---
+		want := 8 + mapSize(reflect.TypeOf(m), 2) + 3
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		// --- Given ---
+		s := []any{int64(1), "ab", &TSize{}}
+
+		// --- When ---
+		have := SizeOf(s)
+
+		// --- Then ---
+		size := int64(reflect.TypeOf(TSize{}).Size())
+		assert.Equal(t, 24+3*16+8+(16+2)+size, have)
+	})
+
+	t.Run("array", func(t *testing.T) {
+		// --- Given ---
+		a := [2]string{"a", "bc"}
+
+		// --- When ---
+		have := SizeOf(a)
+
+		// --- Then ---
+		assert.Equal(t, int64(2*16+3), have)
+	})
+
+	t.Run("channel", func(t *testing.T) {
+		// --- Given ---
+		ch := make(chan int64, 4)
+
+		// --- When ---
+		have := SizeOf(ch)
+
+		// --- Then ---
+		assert.Equal(t, int64(8+32), have)
+	})
+
+	t.Run("depth zero", func(t *testing.T) {
+		// --- Given ---
+		s := TSize{Name: "abc", Next: &TSize{}}
+
+		// --- When ---
+		have := SizeOf(s, WithSizeDepth(0))
+
+		// --- Then ---
+		size := int64(reflect.TypeOf(TSize{}).Size())
+		assert.Equal(t, size, have)
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		// --- Given ---
+		s := TSize{Name: "abc", Next: &TSize{Name: "de"}}
+
+		// --- When ---
+		have := SizeOf(s, WithSizeDepth(1))
+
+		// --- Then ---
+		size := int64(reflect.TypeOf(TSize{}).Size())
+		assert.Equal(t, size+3+size, have)
+	})
+
+	t.Run("nosize field counted by default", func(t *testing.T) {
+		// --- Given ---
+		s := TSize{Data: make([]byte, 100)}
+
+		// --- When ---
+		have := SizeOf(s)
+
+		// --- Then ---
+		size := int64(reflect.TypeOf(TSize{}).Size())
+		assert.Equal(t, size+100, have)
+	})
+
+	t.Run("skip nosize field", func(t *testing.T) {
+		// --- Given ---
+		s := TSize{Data: make([]byte, 100)}
+
+		// --- When ---
+		have := SizeOf(s, WithSkipNoSize())
+
+		// --- Then ---
+		size := int64(reflect.TypeOf(TSize{}).Size())
+		assert.Equal(t, size, have)
+	})
+
+	t.Run("unexported fields", func(t *testing.T) {
+		// --- Given ---
+		s := struct{ name string }{name: "abc"}
+
+		// --- When ---
+		have := SizeOf(s)
+
+		// --- Then ---
+		assert.Equal(t, int64(16+3), have)
+	})
+}
+
+func Test_mapSize(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have := mapSize(reflect.TypeOf(map[int64]int64{}), 0)
+
+		// --- Then ---
+		assert.Equal(t, int64(48+8*17), have)
+	})
+
+	t.Run("grows", func(t *testing.T) {
+		// --- When ---
+		have := mapSize(reflect.TypeOf(map[int64]int64{}), 8)
+
+		// --- Then ---
+		assert.Equal(t, int64(48+16*17), have)
+	})
+}
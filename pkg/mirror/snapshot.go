@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Snap represents a snapshot of struct field values.
+type Snap struct {
+	value reflect.Value // Deep copy of the struct.
+}
+
+// Snapshot captures values of the struct "v" points to. The values are
+// captured like with [Clone], except the [MirrorTag] opt-outs are ignored, so
+// exported fields tagged with "shallow" or "-" are copied deeply too.
+// Unexported fields are copied as is, so maps, slices and pointers they hold
+// share memory with "v". Panics if "v" is not a non-nil pointer to a struct.
+func Snapshot(v any) *Snap {
+	val := snapValue(v, nil)
+	return &Snap{value: snapClone(val)}
+}
+
+// Changed returns paths of exported fields changed since the snapshot was
+// taken or nil if nothing changed. Paths use Go field names and descend into
+// nested structs and pointers to structs, other values are reported by the
+// field path. Values are compared with [Diff] using options, paths are
+// returned in the order of struct fields.
+//
+// Panics if "v" is not a non-nil pointer to the struct of the snapshot type.
+func (snap *Snap) Changed(v any, opts ...CompareOption) []string {
+	val := snapValue(v, snap.value.Type())
+	var paths []string
+	for _, ch := range Diff(snap.value.Interface(), val.Interface(), opts...) {
+		path, _, _ := strings.Cut(ch.Path, "[")
+		if len(paths) == 0 || paths[len(paths)-1] != path {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// Restore sets all fields of the struct "v" points to, including unexported
+// ones, to the values captured in the snapshot. The snapshot may be restored
+// multiple times. Values referenced by unexported fields are not copied, see
+// [Snapshot] for details.
+//
+// Panics if "v" is not a non-nil pointer to the struct of the snapshot type.
+func (snap *Snap) Restore(v any) {
+	val := snapValue(v, snap.value.Type())
+	val.Set(snapClone(snap.value))
+}
+
+// snapClone returns a deep copy of the value ignoring the [MirrorTag]
+// opt-outs.
+func snapClone(val reflect.Value) reflect.Value {
+	cl := &cloner{seen: make(map[cloneKey]reflect.Value), all: true}
+	return cl.deep(val)
+}
+
+// snapValue returns the struct "v" points to. Panics if "v" is not a non-nil
+// pointer to a struct or when "typ" is not nil and the struct is of other
+// type.
+func snapValue(v any, typ reflect.Type) reflect.Value {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.IsNil() ||
+		val.Elem().Kind() != reflect.Struct {
+
+		panic(fmt.Sprintf("expected non-nil pointer to struct got %T", v))
+	}
+	val = val.Elem()
+	if typ != nil && val.Type() != typ {
+		panic(fmt.Sprintf("expected *%s got %T", typ, v))
+	}
+	return val
+}
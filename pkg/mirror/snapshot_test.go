@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TSnap is a struct used in snapshot tests.
+type TSnap struct {
+	Name    string
+	Age     int
+	Tags    []string
+	Attrs   map[string]TSnapAddress
+	Address *TSnapAddress
+	Home    TSnapAddress
+	priv    int
+}
+
+// TSnapAddress is a struct used in snapshot tests.
+type TSnapAddress struct {
+	City string
+	Zip  string
+}
+
+// TSnapTagged is a struct with clone opt-out tags used in snapshot tests.
+type TSnapTagged struct {
+	Secret  string        `mirror:"-"`
+	Address *TSnapAddress `mirror:"shallow"`
+}
+
+func Test_Snapshot(t *testing.T) {
+	t.Run("deep copy", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnap{Name: "a", Tags: []string{"a"}}
+
+		// --- When ---
+		have := Snapshot(v)
+
+		// --- Then ---
+		v.Tags[0] = "b"
+		want := TSnap{Name: "a", Tags: []string{"a"}}
+		assert.Equal(t, want, have.value.Interface())
+	})
+
+	t.Run("ignores clone opt-out tags", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnapTagged{Secret: "s", Address: &TSnapAddress{City: "c"}}
+
+		// --- When ---
+		have := Snapshot(v)
+
+		// --- Then ---
+		v.Address.City = "d"
+		want := TSnapTagged{Secret: "s", Address: &TSnapAddress{City: "c"}}
+		assert.Equal(t, want, have.value.Interface())
+	})
+
+	t.Run("panics for not pointer", func(t *testing.T) {
+		// --- Then ---
+		assert.Panic(t, func() { Snapshot(TSnap{}) })
+	})
+
+	t.Run("panics for nil pointer", func(t *testing.T) {
+		// --- Then ---
+		assert.Panic(t, func() { Snapshot((*TSnap)(nil)) })
+	})
+
+	t.Run("panics for pointer to not struct", func(t *testing.T) {
+		// --- Then ---
+		assert.Panic(t, func() { Snapshot(Ptr(1)) })
+	})
+}
+
+func Test_Snap_Changed(t *testing.T) {
+	t.Run("nothing changed", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnap{Name: "a", Address: &TSnapAddress{City: "c"}}
+		snap := Snapshot(v)
+
+		// --- When ---
+		have := snap.Changed(v)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("changed fields", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnap{
+			Name:    "a",
+			Tags:    []string{"a", "b"},
+			Attrs:   map[string]TSnapAddress{"a": {}, "b": {}},
+			Address: &TSnapAddress{City: "c"},
+		}
+		snap := Snapshot(v)
+
+		// --- When ---
+		v.Name = "b"
+		v.Tags[0], v.Tags[1] = "x", "y"
+		v.Attrs["a"] = TSnapAddress{City: "x"}
+		v.Attrs["b"] = TSnapAddress{City: "y"}
+		v.Address.City = "d"
+		v.Home.Zip = "z"
+		v.priv = 1
+		have := snap.Changed(v)
+
+		// --- Then ---
+		want := []string{"Name", "Tags", "Attrs", "Address.City", "Home.Zip"}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("fields with clone opt-out tags", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnapTagged{Secret: "s", Address: &TSnapAddress{City: "c"}}
+		snap := Snapshot(v)
+
+		// --- When ---
+		have := snap.Changed(v)
+
+		// --- Then ---
+		assert.Nil(t, have)
+
+		v.Secret = "x"
+		v.Address.City = "d"
+		want := []string{"Secret", "Address.City"}
+		assert.Equal(t, want, snap.Changed(v))
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnap{}
+		snap := Snapshot(v)
+
+		// --- When ---
+		v.Address = &TSnapAddress{}
+		have := snap.Changed(v)
+
+		// --- Then ---
+		assert.Equal(t, []string{"Address"}, have)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnap{}
+		snap := Snapshot(v)
+
+		// --- When ---
+		v.Name = "a"
+		v.Age = 1
+		have := snap.Changed(v, WithIgnorePath("Name"))
+
+		// --- Then ---
+		assert.Equal(t, []string{"Age"}, have)
+	})
+
+	t.Run("panics for other type", func(t *testing.T) {
+		// --- Given ---
+		snap := Snapshot(&TSnap{})
+
+		// --- Then ---
+		assert.Panic(t, func() { snap.Changed(&TSnapAddress{}) })
+	})
+}
+
+func Test_Snap_Restore(t *testing.T) {
+	t.Run("restore", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnap{
+			Name:    "a",
+			Tags:    []string{"a"},
+			Address: &TSnapAddress{City: "c"},
+			priv:    1,
+		}
+		snap := Snapshot(v)
+		v.Name = "b"
+		v.Tags[0] = "b"
+		v.Address.City = "d"
+		v.priv = 2
+
+		// --- When ---
+		snap.Restore(v)
+
+		// --- Then ---
+		want := &TSnap{
+			Name:    "a",
+			Tags:    []string{"a"},
+			Address: &TSnapAddress{City: "c"},
+			priv:    1,
+		}
+		assert.Equal(t, want, v)
+		assert.Nil(t, snap.Changed(v))
+	})
+
+	t.Run("fields with clone opt-out tags", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnapTagged{Secret: "s", Address: &TSnapAddress{City: "c"}}
+		snap := Snapshot(v)
+		v.Secret = "x"
+		v.Address.City = "d"
+
+		// --- When ---
+		snap.Restore(v)
+
+		// --- Then ---
+		want := &TSnapTagged{Secret: "s", Address: &TSnapAddress{City: "c"}}
+		assert.Equal(t, want, v)
+	})
+
+	t.Run("restore multiple times", func(t *testing.T) {
+		// --- Given ---
+		v := &TSnap{Tags: []string{"a"}}
+		snap := Snapshot(v)
+		v.Tags[0] = "b"
+		snap.Restore(v)
+
+		// --- When ---
+		v.Tags[0] = "c"
+		snap.Restore(v)
+
+		// --- Then ---
+		assert.Equal(t, []string{"a"}, v.Tags)
+	})
+
+	t.Run("panics for other type", func(t *testing.T) {
+		// --- Given ---
+		snap := Snapshot(&TSnap{})
+
+		// --- Then ---
+		assert.Panic(t, func() { snap.Restore(&TSnapAddress{}) })
+	})
+}
@@ -4,6 +4,7 @@
 package mirror
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -14,6 +15,7 @@ type StructValue struct {
 	*metadata               // The struct metadata.
 	value     reflect.Value // Indirection of the struct s.
 	kind      reflect.Kind  // Value kind.
+	path      string        // Path to the struct, empty for the root struct.
 }
 
 // NewStructValue wraps a struct pointer of any type and provides an easy
@@ -38,6 +40,67 @@ func NewStructValue(s any) *StructValue {
 	return sv
 }
 
+// NewStructValueOf wraps a struct or a pointer to a struct. Unlike
+// [NewStructValue] structs passed by value are accepted, but they are
+// read-only, see [StructValue.IsReadOnly].
+//
+// It returns an error wrapping [ErrNotStruct] explaining why the value was
+// rejected.
+func NewStructValueOf(s any) (*StructValue, error) {
+	if s == nil {
+		return nil, fmt.Errorf("%w: nil value", ErrNotStruct)
+	}
+	return NewStructValueFromReflect(reflect.ValueOf(s))
+}
+
+// NewStructValueFromReflect wraps a struct or a pointer to a struct given as
+// [reflect.Value], for example, a struct stored in a map. Interfaces are
+// unwrapped, nil pointers and values obtained through unexported struct
+// fields are rejected. Struct values which are not addressable are read-only,
+// see [StructValue.IsReadOnly].
+//
+// It returns an error wrapping [ErrNotStruct] explaining why the value was
+// rejected.
+func NewStructValueFromReflect(val reflect.Value) (*StructValue, error) {
+	if !val.IsValid() {
+		return nil, fmt.Errorf("%w: invalid value", ErrNotStruct)
+	}
+	if !val.CanInterface() {
+		return nil, fmt.Errorf(
+			"%w: value obtained through unexported field",
+			ErrNotStruct,
+		)
+	}
+	if val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return nil, fmt.Errorf("%w: nil interface", ErrNotStruct)
+		}
+		val = val.Elem()
+	}
+	typ := val.Type()
+	switch {
+	case typ.Kind() == reflect.Struct:
+	case typ.Kind() == reflect.Ptr && typ.Elem().Kind() == reflect.Struct:
+		if val.IsNil() {
+			return nil, fmt.Errorf("%w: nil pointer", ErrNotStruct)
+		}
+	case typ.Kind() == reflect.Ptr:
+		return nil, fmt.Errorf(
+			"%w: pointer to %s",
+			ErrNotStruct,
+			typ.Elem().Kind(),
+		)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrNotStruct, typ.Kind())
+	}
+	sv := &StructValue{
+		metadata: ReflectType(typ),
+		value:    val,
+		kind:     val.Kind(),
+	}
+	return sv, nil
+}
+
 // IsPtr returns true if the struct value is a pointer type.
 func (sv *StructValue) IsPtr() bool { return sv.kind == reflect.Ptr }
 
@@ -49,6 +112,18 @@ func (sv *StructValue) IsValid() bool {
 	return sv.value.IsValid()
 }
 
+// IsReadOnly returns true if the struct fields cannot be set because the
+// struct is not addressable, for example, it was passed by value to
+// [NewStructValueOf]. Setters of read-only struct fields return an error
+// wrapping [ErrNotAddressable].
+func (sv *StructValue) IsReadOnly() bool {
+	return !sv.IsPtr() && !sv.value.CanAddr()
+}
+
+// Path returns the path to the struct using Go field names. It is empty for
+// structs created with [NewStructValue].
+func (sv *StructValue) Path() string { return sv.path }
+
 // Metadata returns metadata for the struct type.
 func (sv *StructValue) Metadata() *Metadata { return sv.metadata }
 
@@ -63,7 +138,7 @@ func (sv *StructValue) FieldByName(name string) *FieldValue {
 	}
 	if val = val.FieldByName(name); val.IsValid() {
 		if fld := sv.metadata.FieldByName(name); fld != nil {
-			return NewFieldValue(fld, val)
+			return sv.fieldValue(fld, val)
 		}
 	}
 	return nil
@@ -80,17 +155,112 @@ func (sv *StructValue) FieldByIndex(idx int) *FieldValue {
 	}
 	if fld := sv.metadata.FieldByIndex(idx); fld != nil {
 		if val = val.Field(idx); val.IsValid() {
-			return NewFieldValue(fld, val)
+			return sv.fieldValue(fld, val)
 		}
 	}
 	return nil
 }
 
-// NewIfNil initializes the field value with its zero value if it is nil.
+// fieldValue returns new instance of [FieldValue] with the path relative to
+// the struct.
+func (sv *StructValue) fieldValue(fld *Field, val reflect.Value) *FieldValue {
+	fv := NewFieldValue(fld, val)
+	fv.path = joinPath(sv.path, fld.Name())
+	return fv
+}
+
+// NewIfNil initializes the field value with its zero value if it is nil. It
+// does nothing when the value is not settable, for example, the field of a
+// read-only [StructValue].
 func (sv *StructValue) NewIfNil() *StructValue {
 	val := sv.value
-	if sv.IsPtr() && val.IsNil() {
+	if sv.IsPtr() && val.IsNil() && val.CanSet() {
 		val.Set(reflect.New(sv.Type()))
 	}
 	return sv
 }
+
+// Call calls the exported method by name with given arguments and returns
+// its results. Arguments must be assignable to parameter types or be
+// convertible to them and have the same kind, integers are also converted to
+// numeric parameters when they don't overflow them. Methods with pointer
+// receivers can be called only when the struct is addressable.
+//
+// It returns an error wrapping [ErrNoMethod] when the method doesn't exist
+// or can't be called, and [ErrMethodCall] when the number or types of the
+// arguments do not match the method signature.
+func (sv *StructValue) Call(name string, args ...any) ([]any, error) {
+	recv := sv.value
+	if sv.IsPtr() && recv.IsNil() {
+		return nil, fmt.Errorf("%w: %s: nil receiver", ErrMethodCall, name)
+	}
+	if !sv.IsPtr() && recv.CanAddr() {
+		recv = recv.Addr()
+	}
+	fn := recv.MethodByName(name)
+	if !fn.IsValid() {
+		return nil, fmt.Errorf("%w: %s.%s", ErrNoMethod, sv.Type(), name)
+	}
+
+	typ := fn.Type()
+	num := typ.NumIn()
+	if typ.IsVariadic() {
+		if len(args) < num-1 {
+			return nil, fmt.Errorf(
+				"%w: %s: expected at least %d arguments got %d",
+				ErrMethodCall,
+				name,
+				num-1,
+				len(args),
+			)
+		}
+	} else if len(args) != num {
+		return nil, fmt.Errorf(
+			"%w: %s: expected %d arguments got %d",
+			ErrMethodCall,
+			name,
+			num,
+			len(args),
+		)
+	}
+
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		pt := typ.In(min(i, num-1))
+		if typ.IsVariadic() && i >= num-1 {
+			pt = pt.Elem()
+		}
+		val, ok := convertValue(arg, pt)
+		if !ok && overflows(reflect.ValueOf(arg), pt) {
+			return nil, fmt.Errorf(
+				"%w: %s: argument %d: %v overflows %s",
+				ErrMethodCall,
+				name,
+				i,
+				arg,
+				pt,
+			)
+		}
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: %s: argument %d: cannot use %T as %s",
+				ErrMethodCall,
+				name,
+				i,
+				arg,
+				pt,
+			)
+		}
+		in[i] = val
+	}
+
+	out := fn.Call(in)
+	if len(out) == 0 {
+		return nil, nil
+	}
+	results := make([]any, len(out))
+	for i, val := range out {
+		results[i] = val.Interface()
+	}
+	return results, nil
+}
@@ -4,6 +4,7 @@
 package mirror
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -68,6 +69,192 @@ func Test_NewStructValue(t *testing.T) {
 	})
 }
 
+func Test_NewStructValueOf(t *testing.T) {
+	t.Run("pointer to struct", func(t *testing.T) {
+		// --- Given ---
+		s := &TStruct{}
+
+		// --- When ---
+		have, err := NewStructValueOf(s)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have.IsPtr())
+		assert.False(t, have.IsReadOnly())
+		assert.Same(t, Reflect(s), have.Metadata())
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		// --- Given ---
+		s := TStruct{FStr: "abc"}
+
+		// --- When ---
+		have, err := NewStructValueOf(s)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have.IsPtr())
+		assert.True(t, have.IsReadOnly())
+		val, err := have.FieldByName("FStr").Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", val)
+	})
+
+	t.Run("error - nil", func(t *testing.T) {
+		// --- When ---
+		have, err := NewStructValueOf(nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotStruct, err)
+		assert.ErrorEqual(t, "not a struct: nil value", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - not struct", func(t *testing.T) {
+		// --- When ---
+		have, err := NewStructValueOf(42)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotStruct, err)
+		assert.ErrorEqual(t, "not a struct: int", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - nil pointer to struct", func(t *testing.T) {
+		// --- When ---
+		have, err := NewStructValueOf((*TStruct)(nil))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotStruct, err)
+		assert.ErrorEqual(t, "not a struct: nil pointer", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - pointer to not struct", func(t *testing.T) {
+		// --- When ---
+		have, err := NewStructValueOf(ptr("abc"))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotStruct, err)
+		assert.ErrorEqual(t, "not a struct: pointer to string", err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_NewStructValueFromReflect(t *testing.T) {
+	t.Run("struct in map", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]TStruct{"a": {FStr: "abc"}}
+		val := reflect.ValueOf(m).MapIndex(reflect.ValueOf("a"))
+
+		// --- When ---
+		have, err := NewStructValueFromReflect(val)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have.IsReadOnly())
+		fv := have.FieldByName("FStr")
+		got, err := fv.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", got)
+		err = fv.Set("xyz")
+		assert.ErrorIs(t, ErrNotAddressable, err)
+		assert.ErrorEqual(t, "value not addressable: FStr", err)
+		assert.Equal(t, "abc", m["a"].FStr)
+	})
+
+	t.Run("addressable struct", func(t *testing.T) {
+		// --- Given ---
+		s := &TStruct{}
+		val := reflect.ValueOf(s).Elem()
+
+		// --- When ---
+		have, err := NewStructValueFromReflect(val)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have.IsReadOnly())
+		assert.NoError(t, have.FieldByName("FStr").Set("abc"))
+		assert.Equal(t, "abc", s.FStr)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{"a": TStruct{}}
+		val := reflect.ValueOf(m).MapIndex(reflect.ValueOf("a"))
+
+		// --- When ---
+		have, err := NewStructValueFromReflect(val)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "TStruct", have.Name())
+		assert.True(t, have.IsReadOnly())
+	})
+
+	t.Run("error - invalid", func(t *testing.T) {
+		// --- When ---
+		have, err := NewStructValueFromReflect(reflect.Value{})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotStruct, err)
+		assert.ErrorEqual(t, "not a struct: invalid value", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - nil interface", func(t *testing.T) {
+		// --- Given ---
+		var s any
+		val := reflect.ValueOf(&s).Elem()
+
+		// --- When ---
+		have, err := NewStructValueFromReflect(val)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotStruct, err)
+		assert.ErrorEqual(t, "not a struct: nil interface", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - nil pointer to struct", func(t *testing.T) {
+		// --- Given ---
+		var s *TStruct
+
+		// --- When ---
+		have, err := NewStructValueFromReflect(reflect.ValueOf(s))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotStruct, err)
+		assert.ErrorEqual(t, "not a struct: nil pointer", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - unexported field", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ f TStruct }{}
+		val := reflect.ValueOf(s).Elem().Field(0)
+
+		// --- When ---
+		have, err := NewStructValueFromReflect(val)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotStruct, err)
+		wMsg := "not a struct: value obtained through unexported field"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - slice", func(t *testing.T) {
+		// --- When ---
+		have, err := NewStructValueFromReflect(reflect.ValueOf([]int{}))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotStruct, err)
+		assert.ErrorEqual(t, "not a struct: slice", err)
+		assert.Nil(t, have)
+	})
+}
+
 func Test_NewStructValue_IsPtr(t *testing.T) {
 	t.Run("struct", func(t *testing.T) {
 		// --- Given ---
@@ -120,6 +307,54 @@ func Test_StructValue_IsValid(t *testing.T) {
 	})
 }
 
+func Test_StructValue_IsReadOnly(t *testing.T) {
+	t.Run("pointer", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TStruct{})
+
+		// --- When ---
+		have := sv.IsReadOnly()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("value", func(t *testing.T) {
+		// --- Given ---
+		sv, _ := NewStructValueOf(TStruct{})
+
+		// --- When ---
+		have := sv.IsReadOnly()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("nested field of pointer", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ T TwoStr }{}
+		sv := NewStructValue(s).FieldByName("T").StructValue()
+
+		// --- When ---
+		have := sv.IsReadOnly()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_StructValue_Path(t *testing.T) {
+	// --- Given ---
+	s := &struct{ F int }{}
+	sv := NewStructValue(s)
+
+	// --- When ---
+	have := sv.Path()
+
+	// --- Then ---
+	assert.Equal(t, "", have)
+}
+
 func Test_StructValue_Metadata(t *testing.T) {
 	// --- Given ---
 	s := &struct{ F int }{}
@@ -157,6 +392,7 @@ func Test_StructValue_FieldByName(t *testing.T) {
 
 		// --- Then ---
 		assert.NotNil(t, have)
+		assert.Equal(t, "F", have.Path())
 	})
 
 	t.Run("field of a pointer to a struct", func(t *testing.T) {
@@ -347,4 +583,268 @@ func Test_StructValue_NewIfNil(t *testing.T) {
 		assert.Zero(t, s.F)
 		assert.Same(t, sv, have)
 	})
+
+	t.Run("field of read-only struct", func(t *testing.T) {
+		// --- Given ---
+		s := struct{ F *TStruct }{}
+		parent, _ := NewStructValueOf(s)
+		sv := parent.FieldByName("F").StructValue()
+
+		// --- When ---
+		have := sv.NewIfNil()
+
+		// --- Then ---
+		assert.True(t, have.value.IsNil())
+		assert.Same(t, sv, have)
+	})
+}
+
+func Test_StructValue_Call(t *testing.T) {
+	t.Run("value receiver", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{Val: 42})
+
+		// --- When ---
+		have, err := sv.Call("Get")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []any{42}, have)
+	})
+
+	t.Run("pointer receiver", func(t *testing.T) {
+		// --- Given ---
+		s := &TMethods{}
+		sv := NewStructValue(s)
+
+		// --- When ---
+		have, err := sv.Call("Set", 42)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, have)
+		assert.Equal(t, 42, s.Val)
+	})
+
+	t.Run("arguments are converted", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Add", 1, int8(2))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []any{int64(3), nil}, have)
+	})
+
+	t.Run("integer to float", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Scale", 2)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []any{4.0}, have)
+	})
+
+	t.Run("error result", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Add", -1, 2)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 2, have)
+		assert.ErrorEqual(t, "negative", have[1].(error))
+	})
+
+	t.Run("variadic", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Join", ",", "a", "b")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"a,b"}, have)
+	})
+
+	t.Run("variadic without arguments", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Join", ",")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []any{""}, have)
+	})
+
+	t.Run("nil argument", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Describe", nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"nil"}, have)
+	})
+
+	t.Run("nested addressable struct", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ M TMethods }{}
+		sv := NewStructValue(s).FieldByName("M").StructValue()
+
+		// --- When ---
+		_, err := sv.Call("Set", 42)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 42, s.M.Val)
+	})
+
+	t.Run("error - method not found", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoMethod, err)
+		assert.ErrorEqual(t, "method not found: mirror.TMethods.Abc", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("read-only struct value receiver", func(t *testing.T) {
+		// --- Given ---
+		sv, _ := NewStructValueOf(TMethods{Val: 42})
+
+		// --- When ---
+		have, err := sv.Call("Get")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []any{42}, have)
+	})
+
+	t.Run("error - read-only struct pointer receiver", func(t *testing.T) {
+		// --- Given ---
+		s := TMethods{}
+		sv, _ := NewStructValueOf(s)
+
+		// --- When ---
+		have, err := sv.Call("Set", 42)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoMethod, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - nil receiver", func(t *testing.T) {
+		// --- Given ---
+		s := &struct{ M *TMethods }{}
+		sv := NewStructValue(s).FieldByName("M").StructValue()
+
+		// --- When ---
+		have, err := sv.Call("Get")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMethodCall, err)
+		assert.ErrorEqual(t, "invalid method call: Get: nil receiver", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - too many arguments", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Get", 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMethodCall, err)
+		wMsg := "invalid method call: Get: expected 0 arguments got 1"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - too few variadic arguments", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Join")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMethodCall, err)
+		wMsg := "invalid method call: Join: expected at least 1 arguments " +
+			"got 0"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - argument type", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		have, err := sv.Call("Set", "abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMethodCall, err)
+		wMsg := "invalid method call: Set: argument 0: cannot use string as int"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - argument overflows", func(t *testing.T) {
+		// --- Given ---
+		s := &TMethods{}
+		sv := NewStructValue(s)
+
+		// --- When ---
+		have, err := sv.Call("SetSmall", 300)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMethodCall, err)
+		wMsg := "invalid method call: SetSmall: argument 0: 300 overflows uint8"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+		assert.Equal(t, 0, s.Val)
+	})
+
+	t.Run("error - negative argument for unsigned", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		_, err := sv.Call("SetSmall", -1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMethodCall, err)
+	})
+
+	t.Run("error - variadic argument type", func(t *testing.T) {
+		// --- Given ---
+		sv := NewStructValue(&TMethods{})
+
+		// --- When ---
+		_, err := sv.Call("Join", ",", "a", 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMethodCall, err)
+		wMsg := "invalid method call: Join: argument 2: " +
+			"cannot use int as string"
+		assert.ErrorEqual(t, wMsg, err)
+	})
 }
@@ -4,7 +4,11 @@
 package mirror
 
 import (
+	"fmt"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Tag represents a single structure field tag.
@@ -12,16 +16,54 @@ import (
 // Example:
 //
 //	`key:"name,option0,option1"`
+//
+// Options may have values:
+//
+//	`key:"name,option0=value0,option1=value1"`
+//
+// Tags with keys registered with [RegisterTagParser] are not parsed using the
+// above grammar. Instead, the value returned by the registered parser is
+// available with the [Tag.Value] method or the [TagValue] function.
 type Tag struct {
-	field   string   // Struct field name the tag is attached to.
-	key     string   // Tag key.
-	name    string   // Tag name.
-	options []string // Tag options.
+	field   string            // Struct field name the tag is attached to.
+	key     string            // Tag key.
+	raw     string            // Unparsed tag value.
+	name    string            // Tag name.
+	options []string          // Tag options.
+	values  map[string]string // Option values, nil when there are none.
+	value   any               // Value returned by the registered parser.
+}
+
+// NewTag returns a new instance of [Tag] with the given key, name and options.
+// Options may have values given in the "name=value" form.
+func NewTag(key, name string, options ...string) Tag {
+	raw := name
+	if len(options) > 0 {
+		raw += "," + strings.Join(options, ",")
+	}
+	if len(options) == 0 {
+		options = nil
+	}
+	return Tag{
+		key:     key,
+		raw:     raw,
+		name:    name,
+		options: options,
+		values:  optionValues(options),
+	}
 }
 
 // Key returns the key of the tag.
 func (tag Tag) Key() string { return tag.key }
 
+// Raw returns the original, unparsed tag value.
+func (tag Tag) Raw() string { return tag.raw }
+
+// Value returns the value returned by the parser registered for the tag key
+// with [RegisterTagParser]. It returns nil for keys without a registered
+// parser.
+func (tag Tag) Value() any { return tag.value }
+
 // Name returns the name of the tag.
 func (tag Tag) Name() string { return tag.name }
 
@@ -30,6 +72,77 @@ func (tag Tag) Contains(option string) bool {
 	return slices.Contains(tag.options, option)
 }
 
+// Options returns tag options. The options with values are returned in the
+// "name=value" form. The slice must be considered as read-only.
+func (tag Tag) Options() []string { return tag.options }
+
+// Option returns the value of the option and true if the option exists. For
+// options without a value, it returns an empty string and true.
+func (tag Tag) Option(name string) (string, bool) {
+	if value, ok := tag.values[name]; ok {
+		return value, true
+	}
+	return "", tag.Contains(name)
+}
+
+// OptionInt returns the option value as an integer. It returns an error
+// wrapping [ErrNoTagOption] when the option does not exist or
+// [ErrTagOptionValue] when the value is not an integer.
+func (tag Tag) OptionInt(name string) (int, error) {
+	value, err := tag.optionValue(name)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s: %w", ErrTagOptionValue, name, err)
+	}
+	return n, nil
+}
+
+// OptionBool returns the option value as a boolean. The option without a
+// value is considered true. It returns an error wrapping [ErrNoTagOption]
+// when the option does not exist or [ErrTagOptionValue] when the value is not
+// a boolean.
+func (tag Tag) OptionBool(name string) (bool, error) {
+	value, ok := tag.Option(name)
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrNoTagOption, name)
+	}
+	if _, has := tag.values[name]; !has {
+		return true, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s: %w", ErrTagOptionValue, name, err)
+	}
+	return b, nil
+}
+
+// OptionDuration returns the option value as [time.Duration]. It returns an
+// error wrapping [ErrNoTagOption] when the option does not exist or
+// [ErrTagOptionValue] when the value is not a duration.
+func (tag Tag) OptionDuration(name string) (time.Duration, error) {
+	value, err := tag.optionValue(name)
+	if err != nil {
+		return 0, err
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s: %w", ErrTagOptionValue, name, err)
+	}
+	return d, nil
+}
+
+// optionValue returns the option value or error if the option does not exist.
+func (tag Tag) optionValue(name string) (string, error) {
+	value, ok := tag.Option(name)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrNoTagOption, name)
+	}
+	return value, nil
+}
+
 // NameOrField returns the name of the tag or the field name if the tag is
 // empty or set to the "-" value.
 func (tag Tag) NameOrField() string {
@@ -39,6 +152,19 @@ func (tag Tag) NameOrField() string {
 	return tag.field
 }
 
+// hasName returns true if the tag name is set explicitly. It returns false
+// for the "-" name and when the name is derived from the field name.
+func (tag Tag) hasName() bool {
+	if tag.name == "" || tag.name == "-" {
+		return false
+	}
+	if tag.raw == "" {
+		return true
+	}
+	name, _, _ := strings.Cut(tag.raw, ",")
+	return strings.TrimSpace(name) != ""
+}
+
 // IsIgnored returns true if the tag name is set to the "-" value.
 func (tag Tag) IsIgnored() bool { return tag.name == "-" }
 
@@ -46,3 +172,16 @@ func (tag Tag) IsIgnored() bool { return tag.name == "-" }
 func (tag Tag) IsZero() bool {
 	return tag.key == "" && tag.name == "" && len(tag.options) == 0
 }
+
+// String returns the tag in the canonical struct tag form:
+//
+//	key:"name,option0,option1"
+//
+// Tags returned by [ParseTags] are rendered with their original values. It
+// returns an empty string for zero value tags.
+func (tag Tag) String() string {
+	if tag.key == "" {
+		return ""
+	}
+	return tag.key + ":" + strconv.Quote(tag.raw)
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"sync"
+)
+
+// TagParser parses the raw value of a struct field tag and returns a
+// structured value. The "field" argument is the struct field name.
+type TagParser func(field, value string) (any, error)
+
+var (
+	tagParsers   map[string]TagParser // Registered tag parsers by key.
+	tagParsersMX sync.RWMutex         // Guards tagParsers.
+)
+
+func init() { tagParsers = map[string]TagParser{} }
+
+// RegisterTagParser registers a custom parser for the tag key. Tags with the
+// key are not parsed using the default "name,option,option" grammar; instead,
+// the value returned by the parser is stored on the [Tag]. Passing a nil
+// parser removes the registration.
+//
+// Field metadata is cached, therefore parsers should be registered before
+// any type using the key is reflected - preferably in the init function.
+func RegisterTagParser(key string, parser TagParser) {
+	tagParsersMX.Lock()
+	defer tagParsersMX.Unlock()
+	if parser == nil {
+		delete(tagParsers, key)
+		return
+	}
+	tagParsers[key] = parser
+}
+
+// getTagParser returns parser registered for the tag key or nil.
+func getTagParser(key string) TagParser {
+	tagParsersMX.RLock()
+	defer tagParsersMX.RUnlock()
+	return tagParsers[key]
+}
+
+// TagValue returns the value returned by the parser registered for the tag
+// key. It returns false when the field has no tag with the key, or when the
+// value is not of type T.
+func TagValue[T any](fld *Field, key string) (T, bool) {
+	v, ok := fld.Tag(key).Value().(T)
+	return v, ok
+}
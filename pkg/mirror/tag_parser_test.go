@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/reflectkit"
+)
+
+// gormTag represents parsed "gorm" like tag used in tests.
+type gormTag map[string]string
+
+// parseGormTag parses "gorm" like tag used in tests.
+func parseGormTag(_, value string) (any, error) {
+	gt := gormTag{}
+	for _, part := range strings.Split(value, ";") {
+		k, v, _ := strings.Cut(part, ":")
+		if k == "" {
+			return nil, errors.New("empty key")
+		}
+		gt[k] = v
+	}
+	return gt, nil
+}
+
+// registerTagParser registers the tag parser for the duration of the test.
+func registerTagParser(t *testing.T, key string, parser TagParser) {
+	t.Helper()
+	RegisterTagParser(key, parser)
+	t.Cleanup(func() { RegisterTagParser(key, nil) })
+}
+
+func Test_RegisterTagParser(t *testing.T) {
+	t.Run("register", func(t *testing.T) {
+		// --- When ---
+		registerTagParser(t, "test_register", parseGormTag)
+
+		// --- Then ---
+		assert.NotNil(t, getTagParser("test_register"))
+	})
+
+	t.Run("unregister", func(t *testing.T) {
+		// --- Given ---
+		RegisterTagParser("test_unregister", parseGormTag)
+
+		// --- When ---
+		RegisterTagParser("test_unregister", nil)
+
+		// --- Then ---
+		assert.Nil(t, getTagParser("test_unregister"))
+	})
+}
+
+func Test_getTagParser(t *testing.T) {
+	t.Run("not registered", func(t *testing.T) {
+		// --- When ---
+		have := getTagParser("test_not_registered")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_ParseTags_registered_parser(t *testing.T) {
+	t.Run("registered parser is used", func(t *testing.T) {
+		// --- Given ---
+		registerTagParser(t, "test_gorm", parseGormTag)
+
+		// --- When ---
+		have, err := ParseTags(
+			"F",
+			`json:"f, omitempty" test_gorm:"column:id;primaryKey"`,
+		)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := []Tag{
+			{
+				field:   "F",
+				key:     "json",
+				raw:     "f, omitempty",
+				name:    "f",
+				options: []string{"omitempty"},
+			},
+			{
+				field: "F",
+				key:   "test_gorm",
+				raw:   "column:id;primaryKey",
+				value: gormTag{"column": "id", "primaryKey": ""},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("error - parser error", func(t *testing.T) {
+		// --- Given ---
+		registerTagParser(t, "test_gorm_err", parseGormTag)
+
+		// --- When ---
+		have, err := ParseTags("F", `test_gorm_err:";"`)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagSyntax, err)
+		assert.ErrorContain(t, `"test_gorm_err:\";\"": empty key`, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_TagValue(t *testing.T) {
+	t.Run("registered parser", func(t *testing.T) {
+		// --- Given ---
+		registerTagParser(t, "test_value", parseGormTag)
+		s := &struct {
+			F string `test_value:"column:id"`
+		}{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have, ok := TagValue[gormTag](fld, "test_value")
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, gormTag{"column": "id"}, have)
+		assert.Equal(t, "column:id", fld.Tag("test_value").Raw())
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		// --- Given ---
+		registerTagParser(t, "test_value_type", parseGormTag)
+		s := &struct {
+			F string `test_value_type:"column:id"`
+		}{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have, ok := TagValue[string](fld, "test_value_type")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("no registered parser", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F string `json:"f"`
+		}{}
+		fld := NewField(reflectkit.GetField(t, s, "F"))
+
+		// --- When ---
+		have, ok := TagValue[gormTag](fld, "json")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Nil(t, have)
+	})
+}
@@ -5,10 +5,45 @@ package mirror
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ctx42/testing/pkg/assert"
 )
 
+func Test_NewTag(t *testing.T) {
+	t.Run("name only", func(t *testing.T) {
+		// --- When ---
+		have := NewTag("json", "name")
+
+		// --- Then ---
+		want := Tag{key: "json", raw: "name", name: "name"}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- When ---
+		have := NewTag("db", "id", "pk", "size=36")
+
+		// --- Then ---
+		want := Tag{
+			key:     "db",
+			raw:     "id,pk,size=36",
+			name:    "id",
+			options: []string{"pk", "size=36"},
+			values:  map[string]string{"size": "36"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		// --- When ---
+		have := NewTag("json", "", "omitempty")
+
+		// --- Then ---
+		assert.Equal(t, `json:",omitempty"`, have.String())
+	})
+}
+
 func Test_Tag_Key(t *testing.T) {
 	// --- Given ---
 	tag := Tag{key: "abc"}
@@ -20,6 +55,41 @@ func Test_Tag_Key(t *testing.T) {
 	assert.Equal(t, "abc", have)
 }
 
+func Test_Tag_Raw(t *testing.T) {
+	// --- Given ---
+	tags, _ := ParseTags("F", `tag:" a, b "`)
+
+	// --- When ---
+	have := tags[0].Raw()
+
+	// --- Then ---
+	assert.Equal(t, " a, b ", have)
+}
+
+func Test_Tag_Value(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		tag := Tag{value: 42}
+
+		// --- When ---
+		have := tag.Value()
+
+		// --- Then ---
+		assert.Equal(t, 42, have)
+	})
+
+	t.Run("not set", func(t *testing.T) {
+		// --- Given ---
+		tag := Tag{}
+
+		// --- When ---
+		have := tag.Value()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
 func Test_Tag_Name(t *testing.T) {
 	// --- Given ---
 	tag := Tag{name: "abc"}
@@ -66,6 +136,231 @@ func Test_Tag_Contains(t *testing.T) {
 	})
 }
 
+func Test_Tag_Options(t *testing.T) {
+	t.Run("options", func(t *testing.T) {
+		// --- Given ---
+		tag := Tag{options: []string{"a", "b=1"}}
+
+		// --- When ---
+		have := tag.Options()
+
+		// --- Then ---
+		assert.Equal(t, []string{"a", "b=1"}, have)
+	})
+
+	t.Run("zero value tag", func(t *testing.T) {
+		// --- Given ---
+		tag := Tag{}
+
+		// --- When ---
+		have := tag.Options()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Tag_Option(t *testing.T) {
+	t.Run("option with value", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,type=uuid,size=36"`)
+
+		// --- When ---
+		have, ok := tags[0].Option("type")
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, "uuid", have)
+	})
+
+	t.Run("option with empty value", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,type="`)
+
+		// --- When ---
+		have, ok := tags[0].Option("type")
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("option without value", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,pk,size=36"`)
+
+		// --- When ---
+		have, ok := tags[0].Option("pk")
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("option does not exist", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,pk,size=36"`)
+
+		// --- When ---
+		have, ok := tags[0].Option("abc")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("zero value tag", func(t *testing.T) {
+		// --- Given ---
+		tag := Tag{}
+
+		// --- When ---
+		have, ok := tag.Option("abc")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_Tag_OptionInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,size=36"`)
+
+		// --- When ---
+		have, err := tags[0].OptionInt("size")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 36, have)
+	})
+
+	t.Run("error - does not exist", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id"`)
+
+		// --- When ---
+		have, err := tags[0].OptionInt("size")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoTagOption, err)
+		assert.ErrorEqual(t, "tag option not found: size", err)
+		assert.Equal(t, 0, have)
+	})
+
+	t.Run("error - invalid value", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,size=abc"`)
+
+		// --- When ---
+		have, err := tags[0].OptionInt("size")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagOptionValue, err)
+		assert.Equal(t, 0, have)
+	})
+
+	t.Run("error - option without value", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,size"`)
+
+		// --- When ---
+		have, err := tags[0].OptionInt("size")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagOptionValue, err)
+		assert.Equal(t, 0, have)
+	})
+}
+
+func Test_Tag_OptionBool(t *testing.T) {
+	t.Run("option without value", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,pk"`)
+
+		// --- When ---
+		have, err := tags[0].OptionBool("pk")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("option with value", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,pk=false"`)
+
+		// --- When ---
+		have, err := tags[0].OptionBool("pk")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("error - does not exist", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id"`)
+
+		// --- When ---
+		have, err := tags[0].OptionBool("pk")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoTagOption, err)
+		assert.False(t, have)
+	})
+
+	t.Run("error - invalid value", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `db:"id,pk=abc"`)
+
+		// --- When ---
+		have, err := tags[0].OptionBool("pk")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagOptionValue, err)
+		assert.False(t, have)
+	})
+}
+
+func Test_Tag_OptionDuration(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `cache:"key,ttl=1m30s"`)
+
+		// --- When ---
+		have, err := tags[0].OptionDuration("ttl")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 90*time.Second, have)
+	})
+
+	t.Run("error - does not exist", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `cache:"key"`)
+
+		// --- When ---
+		have, err := tags[0].OptionDuration("ttl")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoTagOption, err)
+		assert.Equal(t, time.Duration(0), have)
+	})
+
+	t.Run("error - invalid value", func(t *testing.T) {
+		// --- Given ---
+		tags, _ := ParseTags("F", `cache:"key,ttl=abc"`)
+
+		// --- When ---
+		have, err := tags[0].OptionDuration("ttl")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTagOptionValue, err)
+		assert.Equal(t, time.Duration(0), have)
+	})
+}
+
 func Test_Tag_NameOrField(t *testing.T) {
 	t.Run("zero value tag", func(t *testing.T) {
 		// --- Given ---
@@ -112,6 +407,33 @@ func Test_Tag_NameOrField(t *testing.T) {
 	})
 }
 
+func Test_Tag_hasName_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		tag  Tag
+		want bool
+	}{
+		{"zero value", Tag{}, false},
+		{"name", Tag{name: "a", raw: "a"}, true},
+		{"name without raw", Tag{name: "a"}, true},
+		{"ignored", Tag{name: "-", raw: "-"}, false},
+		{"field name", Tag{field: "F", name: "F", raw: ",omitempty"}, false},
+		{"field name spaces", Tag{field: "F", name: "F", raw: " ,a"}, false},
+		{"constructed", NewTag("json", "a", "omitempty"), true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.tag.hasName()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
 func Test_Tag_IsIgnored_tabular(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -205,3 +527,57 @@ func Test_Tag_IsZero(t *testing.T) {
 		assert.False(t, have)
 	})
 }
+
+func Test_Tag_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		tag  Tag
+		want string
+	}{
+		{"zero value", Tag{}, ""},
+		{"name", NewTag("json", "name"), `json:"name"`},
+		{"empty", NewTag("json", ""), `json:""`},
+		{"options", NewTag("json", "n", "a", "b"), `json:"n,a,b"`},
+		{"quote", NewTag("t", `a"b`), `t:"a\"b"`},
+		{"backslash", NewTag("t", `a\b`), `t:"a\\b"`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.tag.String()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Tag_String_round_trip_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		tag string
+	}{
+		{"name", `json:"name"`},
+		{"empty", `json:""`},
+		{"field name", `json:",omitempty"`},
+		{"whitespace", `tag:" t1, t2 "`},
+		{"escaped quote", `tag:"t1,t2\""`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			tags, err := ParseTags("F", tc.tag)
+			assert.NoError(t, err)
+
+			// --- When ---
+			have := tags[0].String()
+
+			// --- Then ---
+			assert.Equal(t, tc.tag, have)
+		})
+	}
+}
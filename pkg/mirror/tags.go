@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"strings"
+)
+
+// Tags represents a collection of struct field tags in the order they were
+// defined. The result of [ParseTags] may be converted to it:
+//
+//	tags, err := ParseTags("Field", `json:"name" db:"id"`)
+//	collection := Tags(tags)
+type Tags []Tag
+
+// Get returns a tag by key and true, or a zero value tag and false if the
+// tag with the key does not exist.
+func (tags Tags) Get(key string) (Tag, bool) {
+	for _, tag := range tags {
+		if tag.key == key {
+			return tag, true
+		}
+	}
+	return Tag{}, false
+}
+
+// Set replaces the tag with the same key keeping its position or appends the
+// tag at the end of the collection.
+func (tags *Tags) Set(tag Tag) { *tags = setTag(*tags, tag) }
+
+// Delete removes the tag with the key from the collection.
+func (tags *Tags) Delete(key string) {
+	for i, tag := range *tags {
+		if tag.key == key {
+			*tags = append((*tags)[:i], (*tags)[i+1:]...)
+			return
+		}
+	}
+}
+
+// String returns tags in the canonical struct tag form:
+//
+//	json:"name,omitempty" db:"id"
+func (tags Tags) String() string {
+	parts := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if s := tag.String(); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
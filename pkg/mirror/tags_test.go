@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_Tags_Get(t *testing.T) {
+	t.Run("exists", func(t *testing.T) {
+		// --- Given ---
+		tags := Tags{NewTag("json", "a"), NewTag("db", "b")}
+
+		// --- When ---
+		have, ok := tags.Get("db")
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, NewTag("db", "b"), have)
+	})
+
+	t.Run("does not exist", func(t *testing.T) {
+		// --- Given ---
+		tags := Tags{NewTag("json", "a")}
+
+		// --- When ---
+		have, ok := tags.Get("db")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.True(t, have.IsZero())
+	})
+}
+
+func Test_Tags_Set(t *testing.T) {
+	t.Run("replace keeps position", func(t *testing.T) {
+		// --- Given ---
+		tags := Tags{NewTag("json", "a"), NewTag("db", "b")}
+
+		// --- When ---
+		tags.Set(NewTag("json", "c"))
+
+		// --- Then ---
+		want := Tags{NewTag("json", "c"), NewTag("db", "b")}
+		assert.Equal(t, want, tags)
+	})
+
+	t.Run("append", func(t *testing.T) {
+		// --- Given ---
+		tags := Tags{NewTag("json", "a")}
+
+		// --- When ---
+		tags.Set(NewTag("db", "b"))
+
+		// --- Then ---
+		want := Tags{NewTag("json", "a"), NewTag("db", "b")}
+		assert.Equal(t, want, tags)
+	})
+
+	t.Run("nil collection", func(t *testing.T) {
+		// --- Given ---
+		var tags Tags
+
+		// --- When ---
+		tags.Set(NewTag("db", "b"))
+
+		// --- Then ---
+		assert.Equal(t, Tags{NewTag("db", "b")}, tags)
+	})
+}
+
+func Test_Tags_Delete(t *testing.T) {
+	t.Run("delete", func(t *testing.T) {
+		// --- Given ---
+		tags := Tags{NewTag("json", "a"), NewTag("db", "b"), NewTag("x", "c")}
+
+		// --- When ---
+		tags.Delete("db")
+
+		// --- Then ---
+		want := Tags{NewTag("json", "a"), NewTag("x", "c")}
+		assert.Equal(t, want, tags)
+	})
+
+	t.Run("does not exist", func(t *testing.T) {
+		// --- Given ---
+		tags := Tags{NewTag("json", "a")}
+
+		// --- When ---
+		tags.Delete("db")
+
+		// --- Then ---
+		assert.Equal(t, Tags{NewTag("json", "a")}, tags)
+	})
+}
+
+func Test_Tags_String(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		var tags Tags
+
+		// --- When ---
+		have := tags.String()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("constructed", func(t *testing.T) {
+		// --- Given ---
+		tags := Tags{NewTag("json", "a", "omitempty"), NewTag("db", "id")}
+
+		// --- When ---
+		have := tags.String()
+
+		// --- Then ---
+		assert.Equal(t, `json:"a,omitempty" db:"id"`, have)
+	})
+
+	t.Run("round trip preserves order", func(t *testing.T) {
+		// --- Given ---
+		stag := `yaml:"b" json:",omitempty" db:"id,type=uuid"`
+		parsed, err := ParseTags("F", stag)
+		assert.NoError(t, err)
+		tags := Tags(parsed)
+
+		// --- When ---
+		have := tags.String()
+
+		// --- Then ---
+		assert.Equal(t, stag, have)
+	})
+
+	t.Run("modified", func(t *testing.T) {
+		// --- Given ---
+		parsed, err := ParseTags("F", `yaml:"b" json:"a" db:"id"`)
+		assert.NoError(t, err)
+		tags := Tags(parsed)
+
+		// --- When ---
+		tags.Set(NewTag("json", "c", "omitempty"))
+		tags.Delete("yaml")
+
+		// --- Then ---
+		assert.Equal(t, `json:"c,omitempty" db:"id"`, tags.String())
+	})
+}
@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// NameStyle represents the style of rendering qualified type names.
+type NameStyle int
+
+// Styles of rendering qualified type names.
+const (
+	// NameShort qualifies names with the last element of the import path,
+	// for example, "mirror.Box[int]".
+	NameShort NameStyle = iota
+
+	// NameFull qualifies names with the import path, for example,
+	// "github.com/ctx42/mirror/pkg/mirror.Box[int]".
+	NameFull
+
+	// NameGoSource renders names the way they are written in Go source code.
+	// Names are qualified with the package name assumed from the import
+	// path, for example, "yaml.Node" for "gopkg.in/yaml.v3", and type
+	// arguments are separated by a comma and a space.
+	NameGoSource
+)
+
+// TypeRef represents a type reference parsed from a type name.
+type TypeRef struct {
+	// Package is the import path of the named type. It is empty for
+	// predeclared and unnamed types.
+	Package string
+
+	// Name is the base name of the named type or the type expression, with
+	// import paths as qualifiers, for unnamed types.
+	Name string
+
+	// Args are type arguments of the generic type.
+	Args []TypeRef
+}
+
+// QualifiedName returns the type reference rendered in the given style.
+func (tr TypeRef) QualifiedName(style NameStyle) string {
+	if tr.Package == "" && tr.Args == nil {
+		return qualifyExpr(tr.Name, style)
+	}
+	return qualifyName(tr.Package, tr.Name, tr.Args, style)
+}
+
+// String returns the type reference rendered in the [NameFull] style.
+func (tr TypeRef) String() string { return tr.QualifiedName(NameFull) }
+
+// BaseName returns the type name without type arguments, for example, "Box"
+// for "Box[int]". Returns empty string for unnamed types.
+func (md *Metadata) BaseName() string {
+	base, _ := splitTypeArgs(md.name)
+	return base
+}
+
+// TypeArgs returns type arguments of the generic type. Returns nil for
+// non-generic types.
+func (md *Metadata) TypeArgs() []TypeRef {
+	_, args := splitTypeArgs(md.name)
+	return args
+}
+
+// QualifiedName returns the type name rendered in the given style. Unnamed
+// types are rendered as type expressions, for example, "[]*mirror.User".
+func (md *Metadata) QualifiedName(style NameStyle) string {
+	if md.name == "" {
+		return typeName(md.typ, style)
+	}
+	base, args := splitTypeArgs(md.name)
+	return qualifyName(md.pkg, base, args, style)
+}
+
+// typeName returns the type rendered in the given style.
+func typeName(typ reflect.Type, style NameStyle) string {
+	if typ.Name() != "" {
+		base, args := splitTypeArgs(typ.Name())
+		return qualifyName(typ.PkgPath(), base, args, style)
+	}
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return "*" + typeName(typ.Elem(), style)
+
+	case reflect.Slice:
+		return "[]" + typeName(typ.Elem(), style)
+
+	case reflect.Array:
+		return "[" + strconv.Itoa(typ.Len()) + "]" + typeName(typ.Elem(), style)
+
+	case reflect.Map:
+		key := typeName(typ.Key(), style)
+		return "map[" + key + "]" + typeName(typ.Elem(), style)
+
+	case reflect.Chan:
+		prefix := "chan "
+		switch typ.ChanDir() {
+		case reflect.RecvDir:
+			prefix = "<-chan "
+		case reflect.SendDir:
+			prefix = "chan<- "
+		default:
+		}
+		return prefix + typeName(typ.Elem(), style)
+
+	case reflect.Func:
+		return funcTypeName(typ, style)
+
+	default:
+		// Unnamed structs and interfaces.
+		return typ.String()
+	}
+}
+
+// funcTypeName returns the function type rendered in the given style.
+func funcTypeName(typ reflect.Type, style NameStyle) string {
+	var sb strings.Builder
+	sb.WriteString("func(")
+	for i := 0; i < typ.NumIn(); i++ {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		in := typ.In(i)
+		if typ.IsVariadic() && i == typ.NumIn()-1 {
+			sb.WriteString("...")
+			in = in.Elem()
+		}
+		sb.WriteString(typeName(in, style))
+	}
+	sb.WriteString(")")
+	switch n := typ.NumOut(); n {
+	case 0:
+	case 1:
+		sb.WriteString(" " + typeName(typ.Out(0), style))
+	default:
+		sb.WriteString(" (")
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(typeName(typ.Out(i), style))
+		}
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
+
+// qualifyName returns the named type rendered in the given style.
+func qualifyName(pkg, base string, args []TypeRef, style NameStyle) string {
+	name := base
+	if pkg != "" {
+		name = packageName(pkg, style) + "." + base
+	}
+	if args == nil {
+		return name
+	}
+	sep := ","
+	if style == NameGoSource {
+		sep = ", "
+	}
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		strs[i] = arg.QualifiedName(style)
+	}
+	return name + "[" + strings.Join(strs, sep) + "]"
+}
+
+// qualifyExpr returns the type expression, with import paths as qualifiers,
+// rendered in the given style.
+func qualifyExpr(expr string, style NameStyle) string {
+	if style == NameFull {
+		return expr
+	}
+	var sb strings.Builder
+	for expr != "" {
+		i := strings.IndexAny(expr, "[]*(),;{} ")
+		if i == -1 {
+			i = len(expr)
+		}
+		tok := expr[:i]
+		if imp, name := splitOnLastPeriod(tok); name != "" {
+			tok = packageName(imp, style) + "." + name
+		}
+		sb.WriteString(tok)
+		if i < len(expr) {
+			sb.WriteByte(expr[i])
+			i++
+		}
+		expr = expr[i:]
+	}
+	return sb.String()
+}
+
+// packageName returns the package qualifier for the import path in the given
+// style.
+func packageName(imp string, style NameStyle) string {
+	switch style {
+	case NameFull:
+		return imp
+
+	case NameGoSource:
+		// Use the same rules as goimports to assume the package name.
+		base := path.Base(imp)
+		if strings.HasPrefix(base, "v") {
+			if _, err := strconv.Atoi(base[1:]); err == nil {
+				if dir := path.Dir(imp); dir != "." {
+					base = path.Base(dir)
+				}
+			}
+		}
+		base = strings.TrimPrefix(base, "go-")
+		notIdent := func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+		}
+		if i := strings.IndexFunc(base, notIdent); i >= 0 {
+			base = base[:i]
+		}
+		return base
+
+	default:
+		return path.Base(imp)
+	}
+}
+
+// splitTypeArgs splits the generic type name into the base name and parsed
+// type arguments. Returns nil arguments for non-generic type names.
+func splitTypeArgs(name string) (string, []TypeRef) {
+	i := strings.IndexByte(name, '[')
+	if i == -1 || !strings.HasSuffix(name, "]") {
+		return name, nil
+	}
+	var args []TypeRef
+	for _, arg := range splitTopLevel(name[i+1 : len(name)-1]) {
+		args = append(args, parseTypeRef(arg))
+	}
+	return name[:i], args
+}
+
+// parseTypeRef parses the type reference as rendered by the reflect package.
+func parseTypeRef(s string) TypeRef {
+	head := s
+	if i := strings.IndexByte(s, '['); i != -1 {
+		head = s[:i]
+	}
+	switch {
+	case head == "", strings.ContainsAny(head, " *(){}"),
+		head == "map", head == "chan", head == "func":
+		return TypeRef{Name: s} // Unnamed type.
+	}
+	imp, name := splitOnLastPeriod(head)
+	if name == "" {
+		imp, name = "", head // Predeclared type.
+	}
+	if len(head) < len(s) {
+		_, args := splitTypeArgs(s)
+		return TypeRef{Package: imp, Name: name, Args: args}
+	}
+	return TypeRef{Package: imp, Name: name}
+}
+
+// splitTopLevel splits the string on commas which are not nested in
+// brackets, parentheses or braces.
+func splitTopLevel(s string) []string {
+	var parts []string
+	var depth, start int
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[', '(', '{':
+			depth++
+		case ']', ')', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		default:
+		}
+	}
+	return append(parts, s[start:])
+}
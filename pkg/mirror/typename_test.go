@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TBox is a generic type used in type name tests.
+type TBox[T any] struct{ Val T }
+
+// TPair is a generic type used in type name tests.
+type TPair[K comparable, V any] struct {
+	Key K
+	Val V
+}
+
+const pkgPath = "github.com/ctx42/mirror/pkg/mirror"
+
+func Test_TypeRef_QualifiedName(t *testing.T) {
+	t.Run("predeclared", func(t *testing.T) {
+		// --- Given ---
+		tr := TypeRef{Name: "int"}
+
+		// --- When ---
+		have := tr.QualifiedName(NameShort)
+
+		// --- Then ---
+		assert.Equal(t, "int", have)
+	})
+
+	t.Run("named", func(t *testing.T) {
+		// --- Given ---
+		tr := TypeRef{Package: "gopkg.in/yaml.v3", Name: "Node"}
+
+		// --- Then ---
+		assert.Equal(t, "yaml.v3.Node", tr.QualifiedName(NameShort))
+		assert.Equal(t, "gopkg.in/yaml.v3.Node", tr.QualifiedName(NameFull))
+		assert.Equal(t, "yaml.Node", tr.QualifiedName(NameGoSource))
+	})
+
+	t.Run("unnamed", func(t *testing.T) {
+		// --- Given ---
+		tr := TypeRef{Name: "map[string][]*github.com/a/go-b/v2.C"}
+
+		// --- Then ---
+		assert.Equal(t, "map[string][]*v2.C", tr.QualifiedName(NameShort))
+		assert.Equal(t, tr.Name, tr.QualifiedName(NameFull))
+		assert.Equal(t, "map[string][]*b.C", tr.QualifiedName(NameGoSource))
+	})
+
+	t.Run("generic", func(t *testing.T) {
+		// --- Given ---
+		tr := TypeRef{
+			Package: "a/b",
+			Name:    "C",
+			Args:    []TypeRef{{Name: "int"}, {Package: "d/e", Name: "F"}},
+		}
+
+		// --- Then ---
+		assert.Equal(t, "b.C[int,e.F]", tr.QualifiedName(NameShort))
+		assert.Equal(t, "a/b.C[int,d/e.F]", tr.QualifiedName(NameFull))
+		assert.Equal(t, "b.C[int, e.F]", tr.QualifiedName(NameGoSource))
+		assert.Equal(t, "a/b.C[int,d/e.F]", tr.String())
+	})
+}
+
+func Test_Metadata_BaseName(t *testing.T) {
+	t.Run("generic", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TPair[string, TBox[int]]{})
+
+		// --- When ---
+		have := md.BaseName()
+
+		// --- Then ---
+		assert.Equal(t, "TPair", have)
+	})
+
+	t.Run("not generic", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TStruct{})
+
+		// --- When ---
+		have := md.BaseName()
+
+		// --- Then ---
+		assert.Equal(t, "TStruct", have)
+	})
+
+	t.Run("unnamed", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata([]int{})
+
+		// --- When ---
+		have := md.BaseName()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_Metadata_TypeArgs(t *testing.T) {
+	t.Run("generic", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TPair[string, TBox[map[int]*TStruct]]{})
+
+		// --- When ---
+		have := md.TypeArgs()
+
+		// --- Then ---
+		want := []TypeRef{
+			{Name: "string"},
+			{
+				Package: pkgPath,
+				Name:    "TBox",
+				Args:    []TypeRef{{Name: "map[int]*" + pkgPath + ".TStruct"}},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("unnamed args", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TPair[[2]int, func(int, string) error]{})
+
+		// --- When ---
+		have := md.TypeArgs()
+
+		// --- Then ---
+		want := []TypeRef{
+			{Name: "[2]int"},
+			{Name: "func(int, string) error"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("not generic", func(t *testing.T) {
+		// --- Given ---
+		md := NewMetadata(TStruct{})
+
+		// --- When ---
+		have := md.TypeArgs()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Metadata_QualifiedName_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v     any
+		style NameStyle
+		want  string
+	}{
+		{"short", TStruct{}, NameShort, "mirror.TStruct"},
+		{"full", TStruct{}, NameFull, pkgPath + ".TStruct"},
+		{"go source", TStruct{}, NameGoSource, "mirror.TStruct"},
+		{"predeclared", 1, NameFull, "int"},
+		{"generic short", TBox[int]{}, NameShort, "mirror.TBox[int]"},
+		{
+			"generic nested short",
+			TPair[string, TBox[bytes.Buffer]]{},
+			NameShort,
+			"mirror.TPair[string,mirror.TBox[bytes.Buffer]]",
+		},
+		{
+			"generic nested full",
+			TPair[string, TBox[bytes.Buffer]]{},
+			NameFull,
+			pkgPath + ".TPair[string," + pkgPath + ".TBox[bytes.Buffer]]",
+		},
+		{
+			"generic nested go source",
+			TPair[string, TBox[bytes.Buffer]]{},
+			NameGoSource,
+			"mirror.TPair[string, mirror.TBox[bytes.Buffer]]",
+		},
+		{
+			"generic unnamed args",
+			TBox[map[string][]*TStruct]{},
+			NameShort,
+			"mirror.TBox[map[string][]*mirror.TStruct]",
+		},
+		{"slice", []*TStruct{}, NameShort, "[]*mirror.TStruct"},
+		{"array", [2]TStruct{}, NameFull, "[2]" + pkgPath + ".TStruct"},
+		{
+			"map",
+			map[string]TBox[int]{},
+			NameShort,
+			"map[string]mirror.TBox[int]",
+		},
+		{"chan", make(<-chan TStruct), NameShort, "<-chan mirror.TStruct"},
+		{
+			"func",
+			func(int, ...TStruct) (bool, error) { return false, nil },
+			NameShort,
+			"func(int, ...mirror.TStruct) (bool, error)",
+		},
+		{"struct", struct{ A int }{}, NameShort, "struct { A int }"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			md := NewTypeMetadata(reflect.TypeOf(tc.v))
+
+			// --- When ---
+			have := md.QualifiedName(tc.style)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_qualifyExpr_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		expr  string
+		style NameStyle
+		want  string
+	}{
+		{"full", "map[string]a/b.T", NameFull, "map[string]a/b.T"},
+		{"short", "map[string]a/b.T", NameShort, "map[string]b.T"},
+		{
+			"dot in last path element",
+			"[]gopkg.in/yaml.v3.Node",
+			NameGoSource,
+			"[]yaml.Node",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := qualifyExpr(tc.expr, tc.style)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_parseTypeRef_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		s    string
+		want TypeRef
+	}{
+		{"predeclared", "int", TypeRef{Name: "int"}},
+		{"named", "a/b.T", TypeRef{Package: "a/b", Name: "T"}},
+		{
+			"dot in last path element",
+			"gopkg.in/yaml.v3.Node",
+			TypeRef{Package: "gopkg.in/yaml.v3", Name: "Node"},
+		},
+		{"unnamed", "[]a/b.T", TypeRef{Name: "[]a/b.T"}},
+		{
+			"generic",
+			"a/b.T[int]",
+			TypeRef{Package: "a/b", Name: "T", Args: []TypeRef{{Name: "int"}}},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := parseTypeRef(tc.s)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_packageName_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		imp   string
+		style NameStyle
+		want  string
+	}{
+		{"short", "a/b", NameShort, "b"},
+		{"full", "a/b", NameFull, "a/b"},
+		{"go source", "a/b", NameGoSource, "b"},
+		{"go source version", "a/b/v2", NameGoSource, "b"},
+		{"go source dot version", "gopkg.in/yaml.v3", NameGoSource, "yaml"},
+		{"go source go prefix", "a/go-b", NameGoSource, "b"},
+		{"go source dash", "a/b-c", NameGoSource, "b"},
+		{"go source std", "bytes", NameGoSource, "bytes"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := packageName(tc.imp, tc.style)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,503 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// DefaultValidateTag is the default struct tag key with validation rules.
+const DefaultValidateTag = "validate"
+
+// Special validation rule names.
+const (
+	ruleSkip      = "-"         // Skip the field.
+	ruleOmitEmpty = "omitempty" // Skip other rules when the value is empty.
+	ruleRequired  = "required"  // Value must not be empty.
+	ruleDive      = "dive"      // Apply following rules to elements.
+	ruleRegexp    = "regexp"    // Consumes the rest of the tag as parameter.
+)
+
+// RuleContext describes the value validated by a [RuleFunc].
+type RuleContext struct {
+	Value  reflect.Value // Validated value, pointers are dereferenced.
+	Param  string        // Rule parameter, empty when not provided.
+	Field  *Field        // Struct field the value belongs to.
+	Parent reflect.Value // Struct the field belongs to.
+}
+
+// RuleFunc returns true when the value satisfies the rule.
+type RuleFunc func(rc RuleContext) bool
+
+// ruleCompiler checks the rule parameter against the type the rule is applied
+// to and returns the function performing the validation.
+type ruleCompiler func(param string, typ, parent reflect.Type) (RuleFunc, error)
+
+// ValidationError represents a single failed validation rule.
+type ValidationError struct {
+	Path    string // Path to the value using Go field names.
+	TagPath string // Path to the value using tag names.
+	Rule    string // Failed rule name.
+	Param   string // Failed rule parameter, empty when not provided.
+}
+
+func (e *ValidationError) Error() string {
+	rule := e.Rule
+	if e.Param != "" {
+		rule += "=" + e.Param
+	}
+	return fmt.Sprintf("%s: rule %s failed", e.Path, rule)
+}
+
+// Is returns true when the target is [ErrValidation].
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// ValidationErrors represents all validation errors for a value.
+type ValidationErrors []*ValidationError
+
+func (es ValidationErrors) Error() string {
+	msg := make([]string, len(es))
+	for i, e := range es {
+		msg[i] = e.Error()
+	}
+	return strings.Join(msg, "\n")
+}
+
+// Unwrap returns the list of validation errors.
+func (es ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// ValidatorOption represents [Validator] option.
+type ValidatorOption func(*Validator)
+
+// WithValidateTag sets the struct tag key with validation rules.
+func WithValidateTag(key string) ValidatorOption {
+	return func(vd *Validator) { vd.tagKey = key }
+}
+
+// WithPathTag sets the struct tag key used to construct
+// [ValidationError.TagPath]. By default, it is the "json" key.
+func WithPathTag(key string) ValidatorOption {
+	return WithNamingPolicy(TagPolicy(key))
+}
+
+// WithNamingPolicy sets the naming policy used to construct
+// [ValidationError.TagPath].
+func WithNamingPolicy(np NamingPolicy) ValidatorOption {
+	return func(vd *Validator) { vd.naming = np }
+}
+
+// Validator validates structs using rules defined in struct tags.
+//
+// Rules are separated by commas, and a rule parameter is given after the
+// equal sign:
+//
+//	`validate:"required,min=1,max=10"`
+//
+// Built-in rules:
+//
+//   - required - value must not be empty,
+//   - omitempty - skip other rules when the value is empty,
+//   - min, max, len, gt, lt - number ranges or lengths of strings, slices,
+//     arrays and maps,
+//   - oneof - value must be one of space separated strings or numbers,
+//   - regexp - string must match the expression, the rule must be the last
+//     one because its parameter extends to the end of the tag,
+//   - unique - slice, array or map values must be unique,
+//   - eqfield, nefield - value must (not) be equal to other struct field,
+//   - dive - following rules apply to slice, array or map elements.
+//
+// A nil pointer is checked only by the "required" rule, the other rules are
+// skipped. Nested structs and pointers to structs are validated recursively,
+// elements of slices, arrays and maps only after the "dive" rule. Fields
+// with the "-" rule and unexported fields are skipped.
+//
+// Validation rules are parsed once per type and cached.
+type Validator struct {
+	tagKey string                   // Struct tag key with rules.
+	naming NamingPolicy             // Naming policy for tag paths.
+	rules  map[string]ruleCompiler  // Available rules.
+	cache  map[reflect.Type]*sRules // Struct rules cache.
+	mx     sync.RWMutex             // Guards rules and cache.
+}
+
+// NewValidator returns new instance of [Validator].
+func NewValidator(opts ...ValidatorOption) *Validator {
+	vd := &Validator{
+		tagKey: DefaultValidateTag,
+		naming: TagPolicy("json"),
+		rules:  builtinRules(),
+		cache:  make(map[reflect.Type]*sRules),
+	}
+	for _, opt := range opts {
+		opt(vd)
+	}
+	return vd
+}
+
+// RegisterRule registers a custom validation rule. It overrides built-in
+// rule with the same name. Registering a rule resets the cache.
+func (vd *Validator) RegisterRule(name string, fn RuleFunc) {
+	vd.mx.Lock()
+	defer vd.mx.Unlock()
+	vd.rules[name] = func(_ string, _, _ reflect.Type) (RuleFunc, error) {
+		return fn, nil
+	}
+	vd.cache = make(map[reflect.Type]*sRules)
+}
+
+// Validate validates a struct or a pointer to a struct. It returns
+// [ValidationErrors] with all failed rules or nil if the value is valid. It
+// returns an error wrapping [ErrValidationRule] when validation rules are
+// invalid. Unexported fields are skipped, except embedded structs, whose
+// exported fields are promoted.
+func (vd *Validator) Validate(s any) error {
+	val := reflect.ValueOf(s)
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return fmt.Errorf("%w: nil pointer", ErrValidationRule)
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: expected struct got %T", ErrValidationRule, s)
+	}
+
+	var errs ValidationErrors
+	ctx := &vCtx{seen: make(map[uintptr]bool)}
+	if err := vd.validate(ctx, &errs, val, "", ""); err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// vCtx represents a single validation run.
+type vCtx struct {
+	seen map[uintptr]bool // Pointers on the current validation path.
+}
+
+// validate validates struct fields.
+func (vd *Validator) validate(
+	ctx *vCtx,
+	errs *ValidationErrors,
+	val reflect.Value,
+	path, tagPath string,
+) error {
+
+	srs, err := vd.structRules(val.Type())
+	if err != nil {
+		return err
+	}
+	if srs.embedded {
+		// Fields of unexported embedded structs are accessed with unsafe.
+		val = addressable(val)
+	}
+	for _, fr := range srs.fields {
+		fPath := joinPath(path, fr.fld.Name())
+		fTagPath := tagPath
+		if fr.tagName != "" {
+			fTagPath = joinPath(tagPath, fr.tagName)
+		}
+		fv := val.Field(fr.fld.Index()[0])
+		if !fv.CanInterface() {
+			fv = exportValue(fv)
+		}
+		err = vd.check(ctx, errs, fr.fld, fr.rs, fv, val, fPath, fTagPath)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check validates a value against the rule set.
+//
+// nolint: cyclop
+func (vd *Validator) check(
+	ctx *vCtx,
+	errs *ValidationErrors,
+	fld *Field,
+	rs *ruleSet,
+	val, parent reflect.Value,
+	path, tagPath string,
+) error {
+
+	empty := isEmpty(val)
+	if rs.required && empty {
+		*errs = append(*errs, &ValidationError{
+			Path:    path,
+			TagPath: tagPath,
+			Rule:    ruleRequired,
+		})
+		return nil
+	}
+	if rs.omitEmpty && empty {
+		return nil
+	}
+
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return nil
+		}
+		if val.Kind() == reflect.Ptr {
+			ptr := val.Pointer()
+			if ctx.seen[ptr] {
+				return nil // Cycle.
+			}
+			ctx.seen[ptr] = true
+			defer delete(ctx.seen, ptr)
+		}
+		val = val.Elem()
+	}
+
+	for _, r := range rs.rules {
+		rc := RuleContext{
+			Value:  val,
+			Param:  r.param,
+			Field:  fld,
+			Parent: parent,
+		}
+		if !r.fn(rc) {
+			*errs = append(*errs, &ValidationError{
+				Path:    path,
+				TagPath: tagPath,
+				Rule:    r.name,
+				Param:   r.param,
+			})
+		}
+	}
+
+	if rs.dive != nil {
+		switch val.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < val.Len(); i++ {
+				err := vd.check(
+					ctx,
+					errs,
+					fld,
+					rs.dive,
+					val.Index(i),
+					parent,
+					indexPath(path, i),
+					indexPath(tagPath, i),
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+		case reflect.Map:
+			for _, key := range sortedKeys(val) {
+				err := vd.check(
+					ctx,
+					errs,
+					fld,
+					rs.dive,
+					val.MapIndex(key),
+					parent,
+					keyPath(path, key),
+					keyPath(tagPath, key),
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+		default:
+			// Checked when compiling rules.
+		}
+	}
+
+	if val.Kind() == reflect.Struct {
+		return vd.validate(ctx, errs, val, path, tagPath)
+	}
+	return nil
+}
+
+// rule represents compiled validation rule.
+type rule struct {
+	name  string   // Rule name.
+	param string   // Rule parameter.
+	fn    RuleFunc // Rule function.
+}
+
+// ruleSet represents compiled validation rules for a value.
+type ruleSet struct {
+	omitEmpty bool     // Skip rules for empty value.
+	required  bool     // Value is required.
+	rules     []rule   // Rules to check.
+	dive      *ruleSet // Rules for elements, nil when not diving.
+}
+
+// fRules represents validation rules for a struct field.
+type fRules struct {
+	fld     *Field   // The struct field.
+	tagName string   // Name used in tag paths, empty for promoted fields.
+	rs      *ruleSet // Field rules.
+}
+
+// sRules represents validation rules for a struct type.
+type sRules struct {
+	fields   []fRules // Fields to validate.
+	embedded bool     // Has unexported embedded structs.
+}
+
+// structRules returns cached validation rules for the struct type.
+func (vd *Validator) structRules(typ reflect.Type) (*sRules, error) {
+	vd.mx.RLock()
+	srs, found := vd.cache[typ]
+	vd.mx.RUnlock()
+	if found {
+		return srs, nil
+	}
+
+	vd.mx.Lock()
+	defer vd.mx.Unlock()
+	srs = &sRules{}
+	for _, fld := range ReflectType(typ).Fields() {
+		// Like in encoding/json, unexported embedded structs are validated
+		// because their exported fields are promoted.
+		if !fld.IsExported() && (!fld.IsAnonymous() ||
+			indirect(fld.Type()).Kind() != reflect.Struct) {
+
+			continue
+		}
+		value := fld.StructField().Tag.Get(vd.tagKey)
+		if value == ruleSkip {
+			continue
+		}
+		rs, err := vd.compile(fld.Type(), typ, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", typ, fld.Name(), err)
+		}
+		if !fld.IsExported() {
+			srs.embedded = true
+		}
+		var tagName string
+		if !fld.IsAnonymous() || vd.naming.IsNamed(fld) {
+			tagName = vd.naming.Name(fld)
+		}
+		srs.fields = append(srs.fields, fRules{
+			fld:     fld,
+			tagName: tagName,
+			rs:      rs,
+		})
+	}
+	vd.cache[typ] = srs
+	return srs, nil
+}
+
+// compile compiles validation rules for a value of the given type.
+func (vd *Validator) compile(typ, parent reflect.Type, value string) (
+	*ruleSet,
+	error,
+) {
+
+	rs := &ruleSet{}
+	cur := rs
+	elem := indirect(typ)
+	for value != "" {
+		var token string
+		if strings.HasPrefix(value, ruleRegexp+"=") {
+			token, value = value, ""
+		} else {
+			token, value, _ = strings.Cut(value, ",")
+		}
+		name, param, _ := strings.Cut(strings.TrimSpace(token), "=")
+
+		switch name {
+		case "":
+			continue
+
+		case ruleOmitEmpty:
+			cur.omitEmpty = true
+
+		case ruleRequired:
+			cur.required = true
+
+		case ruleDive:
+			switch elem.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				elem = elem.Elem()
+				cur.dive = &ruleSet{}
+				cur = cur.dive
+				elem = indirect(elem)
+			default:
+				return nil, fmt.Errorf(
+					"%w: %s on %s",
+					ErrValidationRule,
+					ruleDive,
+					elem,
+				)
+			}
+
+		default:
+			cmp, ok := vd.rules[name]
+			if !ok {
+				return nil, fmt.Errorf(
+					"%w: unknown rule %q",
+					ErrValidationRule,
+					name,
+				)
+			}
+			fn, err := cmp(param, elem, parent)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"%w: %s=%s on %s: %w",
+					ErrValidationRule,
+					name,
+					param,
+					elem,
+					err,
+				)
+			}
+			cur.rules = append(cur.rules, rule{
+				name:  name,
+				param: param,
+				fn:    fn,
+			})
+		}
+	}
+	return rs, nil
+}
+
+// defValidator is the default validator.
+var defValidator = NewValidator()
+
+// Validate validates a struct or a pointer to a struct using the default
+// [Validator]. See [Validator.Validate] for details.
+func Validate(s any) error { return defValidator.Validate(s) }
+
+// RegisterRule registers custom validation rule with the default [Validator].
+// See [Validator.RegisterRule] for details.
+func RegisterRule(name string, fn RuleFunc) {
+	defValidator.RegisterRule(name, fn)
+}
+
+// isEmpty returns true if the value is considered empty by validation rules.
+// Nil pointers, interfaces and zero length strings, slices and maps are
+// empty as well as all other zero values.
+func isEmpty(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return val.IsNil()
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Chan:
+		return val.Len() == 0
+	default:
+		return val.IsZero()
+	}
+}
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"cmp"
+	"errors"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Errors returned by rule compilers.
+var (
+	errRuleType  = errors.New("rule not applicable to the type")
+	errRuleParam = errors.New("invalid rule parameter")
+)
+
+// builtinRules returns built-in validation rules.
+func builtinRules() map[string]ruleCompiler {
+	return map[string]ruleCompiler{
+		"min":     boundRule(func(c int) bool { return c >= 0 }),
+		"max":     boundRule(func(c int) bool { return c <= 0 }),
+		"len":     boundRule(func(c int) bool { return c == 0 }),
+		"gt":      boundRule(func(c int) bool { return c > 0 }),
+		"lt":      boundRule(func(c int) bool { return c < 0 }),
+		"oneof":   oneOfRule,
+		"regexp":  regexpRule,
+		"unique":  uniqueRule,
+		"eqfield": fieldRule(true),
+		"nefield": fieldRule(false),
+	}
+}
+
+// boundRule returns a compiler for rules comparing numbers or lengths with
+// the rule parameter. The "ok" function receives the result of comparing the
+// value with the parameter.
+func boundRule(ok func(c int) bool) ruleCompiler {
+	return func(param string, typ, _ reflect.Type) (RuleFunc, error) {
+		switch typ.Kind() {
+		case reflect.String:
+			n, err := strconv.Atoi(param)
+			if err != nil || n < 0 {
+				return nil, errRuleParam
+			}
+			return func(rc RuleContext) bool {
+				cnt := utf8.RuneCountInString(rc.Value.String())
+				return ok(cmp.Compare(cnt, n))
+			}, nil
+
+		case reflect.Slice, reflect.Array, reflect.Map:
+			n, err := strconv.Atoi(param)
+			if err != nil || n < 0 {
+				return nil, errRuleParam
+			}
+			return func(rc RuleContext) bool {
+				return ok(cmp.Compare(rc.Value.Len(), n))
+			}, nil
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+			reflect.Int64:
+
+			n, err := strconv.ParseInt(param, 10, 64)
+			if err != nil {
+				return nil, errRuleParam
+			}
+			return func(rc RuleContext) bool {
+				return ok(cmp.Compare(rc.Value.Int(), n))
+			}, nil
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+			reflect.Uint64, reflect.Uintptr:
+
+			n, err := strconv.ParseUint(param, 10, 64)
+			if err != nil {
+				return nil, errRuleParam
+			}
+			return func(rc RuleContext) bool {
+				return ok(cmp.Compare(rc.Value.Uint(), n))
+			}, nil
+
+		case reflect.Float32, reflect.Float64:
+			n, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				return nil, errRuleParam
+			}
+			return func(rc RuleContext) bool {
+				return ok(cmp.Compare(rc.Value.Float(), n))
+			}, nil
+
+		default:
+			return nil, errRuleType
+		}
+	}
+}
+
+// oneOfRule compiles the rule checking the value is one of space separated
+// values given in the parameter.
+func oneOfRule(param string, typ, _ reflect.Type) (RuleFunc, error) {
+	values := strings.Fields(param)
+	if len(values) == 0 {
+		return nil, errRuleParam
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return func(rc RuleContext) bool {
+			return slices.Contains(values, rc.Value.String())
+		}, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+
+		ns := make([]int64, len(values))
+		for i, v := range values {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, errRuleParam
+			}
+			ns[i] = n
+		}
+		return func(rc RuleContext) bool {
+			return slices.Contains(ns, rc.Value.Int())
+		}, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+
+		ns := make([]uint64, len(values))
+		for i, v := range values {
+			n, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, errRuleParam
+			}
+			ns[i] = n
+		}
+		return func(rc RuleContext) bool {
+			return slices.Contains(ns, rc.Value.Uint())
+		}, nil
+
+	default:
+		return nil, errRuleType
+	}
+}
+
+// regexpRule compiles the rule checking a string matches the regular
+// expression given in the parameter.
+func regexpRule(param string, typ, _ reflect.Type) (RuleFunc, error) {
+	if typ.Kind() != reflect.String {
+		return nil, errRuleType
+	}
+	re, err := regexp.Compile(param)
+	if err != nil {
+		return nil, err
+	}
+	return func(rc RuleContext) bool {
+		return re.MatchString(rc.Value.String())
+	}, nil
+}
+
+// uniqueRule compiles the rule checking slice, array or map values are
+// unique. Values of interface elements with not comparable dynamic types
+// are compared using [reflect.DeepEqual].
+func uniqueRule(_ string, typ, _ reflect.Type) (RuleFunc, error) {
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if !typ.Elem().Comparable() {
+			return nil, errRuleType
+		}
+	default:
+		return nil, errRuleType
+	}
+
+	return func(rc RuleContext) bool {
+		val := rc.Value
+		seen := make(map[any]struct{}, val.Len())
+		var others []any // Values with not comparable dynamic types.
+		add := func(v reflect.Value) bool {
+			key := v.Interface()
+			if !v.Comparable() {
+				for _, other := range others {
+					if reflect.DeepEqual(key, other) {
+						return false
+					}
+				}
+				others = append(others, key)
+				return true
+			}
+			if _, ok := seen[key]; ok {
+				return false
+			}
+			seen[key] = struct{}{}
+			return true
+		}
+		if val.Kind() == reflect.Map {
+			iter := val.MapRange()
+			for iter.Next() {
+				if !add(iter.Value()) {
+					return false
+				}
+			}
+			return true
+		}
+		for i := 0; i < val.Len(); i++ {
+			if !add(val.Index(i)) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// fieldRule returns a compiler for rules comparing the value with other
+// field of the same struct.
+func fieldRule(equal bool) ruleCompiler {
+	return func(param string, _, parent reflect.Type) (RuleFunc, error) {
+		sf, ok := parent.FieldByName(param)
+		if !ok || !sf.IsExported() {
+			return nil, errRuleParam
+		}
+		return func(rc RuleContext) bool {
+			other, err := rc.Parent.FieldByIndexErr(sf.Index)
+			if err != nil {
+				return !equal // Promoted through nil embedded pointer.
+			}
+			if !other.CanInterface() && other.CanAddr() {
+				// Promoted from unexported embedded struct.
+				other = exportValue(other)
+			}
+			other = reflect.Indirect(other)
+			if !other.IsValid() {
+				return !equal
+			}
+			have := reflect.DeepEqual(rc.Value.Interface(), other.Interface())
+			return have == equal
+		}, nil
+	}
+}
@@ -0,0 +1,378 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// runRule compiles the built-in rule for the type of "v" and runs it.
+func runRule(t *testing.T, name, param string, v any) (bool, error) {
+	t.Helper()
+	val := reflect.ValueOf(v)
+	fn, err := builtinRules()[name](param, val.Type(), nil)
+	if err != nil {
+		return false, err
+	}
+	return fn(RuleContext{Value: val, Param: param}), nil
+}
+
+func Test_boundRule_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		rule  string
+		param string
+		val   any
+		want  bool
+	}{
+		{"min string", "min", "2", "ab", true},
+		{"min string fail", "min", "3", "ab", false},
+		{"min string runes", "min", "2", "żó", true},
+		{"max string", "max", "2", "ab", true},
+		{"max string fail", "max", "1", "ab", false},
+		{"len slice", "len", "2", []int{1, 2}, true},
+		{"len slice fail", "len", "1", []int{1, 2}, false},
+		{"len array", "len", "2", [2]int{}, true},
+		{"min map", "min", "1", map[int]int{1: 1}, true},
+		{"min int", "min", "-1", -1, true},
+		{"min int fail", "min", "0", -1, false},
+		{"max int8", "max", "10", int8(10), true},
+		{"gt uint", "gt", "10", uint(11), true},
+		{"gt uint fail", "gt", "10", uint(10), false},
+		{"lt float", "lt", "1.5", 1.4, true},
+		{"lt float fail", "lt", "1.5", 1.5, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := runRule(t, tc.rule, tc.param, tc.val)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_boundRule(t *testing.T) {
+	t.Run("error - invalid length", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "min", "-1", "abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleParam, err)
+	})
+
+	t.Run("error - invalid number", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "min", "abc", 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleParam, err)
+	})
+
+	t.Run("error - not applicable", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "min", "1", true)
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleType, err)
+	})
+}
+
+func Test_oneOfRule(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "oneof", "a b c", "b")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("string not on the list", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "oneof", "a b c", "x")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("int", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "oneof", "1 2 3", 3)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("uint", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "oneof", "1 2 3", uint(4))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("error - empty parameter", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "oneof", " ", "a")
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleParam, err)
+	})
+
+	t.Run("error - invalid number", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "oneof", "1 a", 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleParam, err)
+	})
+
+	t.Run("error - not applicable", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "oneof", "1", 1.0)
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleType, err)
+	})
+}
+
+func Test_regexpRule(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "regexp", "^[a,b]+$", "a,b")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "regexp", "^[a,b]+$", "c")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("error - invalid expression", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "regexp", "[", "a")
+
+		// --- Then ---
+		assert.Error(t, err)
+	})
+
+	t.Run("error - not applicable", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "regexp", ".*", 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleType, err)
+	})
+}
+
+func Test_uniqueRule(t *testing.T) {
+	t.Run("unique slice", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "unique", "", []int{1, 2, 3})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("not unique array", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "unique", "", [3]int{1, 2, 1})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("unique map values", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "unique", "", map[int]int{1: 1, 2: 2})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("not unique map values", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "unique", "", map[int]int{1: 1, 2: 1})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("interface elements", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "unique", "", []any{1, "a", 2})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("not unique interface elements", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "unique", "", []any{1, "a", 1})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("interface elements not comparable", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "unique", "", []any{[]int{1}, []int{2}, 1})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("not unique interface elements not comparable", func(t *testing.T) {
+		// --- When ---
+		have, err := runRule(t, "unique", "", []any{[]int{1}, []int{1}})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("error - not comparable elements", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "unique", "", [][]int{})
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleType, err)
+	})
+
+	t.Run("error - not applicable", func(t *testing.T) {
+		// --- When ---
+		_, err := runRule(t, "unique", "", 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleType, err)
+	})
+}
+
+func Test_fieldRule(t *testing.T) {
+	type T struct {
+		A  string
+		B  string
+		P  *string
+		pv string
+	}
+	typ := reflect.TypeOf(T{})
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		s := T{A: "a", B: "a"}
+		fn, err := fieldRule(true)("B", typ.Field(0).Type, typ)
+		assert.NoError(t, err)
+		val := reflect.ValueOf(s)
+
+		// --- When ---
+		have := fn(RuleContext{Value: val.Field(0), Parent: val})
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		s := T{A: "a", B: "b"}
+		fn, err := fieldRule(false)("B", typ.Field(0).Type, typ)
+		assert.NoError(t, err)
+		val := reflect.ValueOf(s)
+
+		// --- When ---
+		have := fn(RuleContext{Value: val.Field(0), Parent: val})
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("pointer field", func(t *testing.T) {
+		// --- Given ---
+		s := T{A: "a", P: ptr("a")}
+		fn, err := fieldRule(true)("P", typ.Field(0).Type, typ)
+		assert.NoError(t, err)
+		val := reflect.ValueOf(s)
+
+		// --- When ---
+		have := fn(RuleContext{Value: val.Field(0), Parent: val})
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("nil pointer field", func(t *testing.T) {
+		// --- Given ---
+		s := T{A: "a"}
+		fn, err := fieldRule(true)("P", typ.Field(0).Type, typ)
+		assert.NoError(t, err)
+		val := reflect.ValueOf(s)
+
+		// --- When ---
+		have := fn(RuleContext{Value: val.Field(0), Parent: val})
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("field promoted through nil embedded pointer", func(t *testing.T) {
+		// --- Given ---
+		type Base struct{ Password string }
+		type Form struct {
+			*Base
+			Confirm string
+		}
+		ft := reflect.TypeOf(Form{})
+		val := reflect.ValueOf(Form{Confirm: "a"})
+
+		// --- When ---
+		eq, err := fieldRule(true)("Password", ft.Field(1).Type, ft)
+		assert.NoError(t, err)
+		ne, err := fieldRule(false)("Password", ft.Field(1).Type, ft)
+		assert.NoError(t, err)
+
+		// --- Then ---
+		assert.False(t, eq(RuleContext{Value: val.Field(1), Parent: val}))
+		assert.True(t, ne(RuleContext{Value: val.Field(1), Parent: val}))
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- When ---
+		_, err := fieldRule(true)("X", typ.Field(0).Type, typ)
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleParam, err)
+	})
+
+	t.Run("error - unexported field", func(t *testing.T) {
+		// --- When ---
+		_, err := fieldRule(true)("pv", typ.Field(0).Type, typ)
+
+		// --- Then ---
+		assert.ErrorIs(t, errRuleParam, err)
+	})
+}
@@ -0,0 +1,581 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package mirror
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// TValidate is a struct used in validation tests.
+type TValidate struct {
+	Name     string            `json:"name" validate:"required,min=2,max=5"`
+	Age      int               `json:"age" validate:"min=18,max=99"`
+	Role     string            `json:"role" validate:"omitempty,oneof=admin user"`
+	Tags     []string          `json:"tags" validate:"unique,dive,required"`
+	Meta     map[string]int    `json:"meta" validate:"dive,max=5"`
+	Address  *TValidateAddress `json:"address"`
+	Password string            `json:"password"`
+	Confirm  string            `json:"confirm" validate:"eqfield=Password"`
+	Skipped  string            `validate:"-"`
+}
+
+// TValidateAddress is a struct used in validation tests.
+type TValidateAddress struct {
+	City string `json:"city" validate:"required"`
+	Zip  string `json:"zip" validate:"regexp=^[0-9]{2,3}-[0-9]{3}$"`
+}
+
+// TValidateNode is a recursive struct used in validation tests.
+type TValidateNode struct {
+	Name string         `validate:"required"`
+	Next *TValidateNode `validate:"omitempty"`
+}
+
+// newTValidate returns valid instance of TValidate.
+func newTValidate() *TValidate {
+	return &TValidate{
+		Name:     "Bob",
+		Age:      42,
+		Role:     "admin",
+		Tags:     []string{"a", "b"},
+		Meta:     map[string]int{"a": 1},
+		Address:  &TValidateAddress{City: "Warsaw", Zip: "00-001"},
+		Password: "secret",
+		Confirm:  "secret",
+	}
+}
+
+func Test_ValidationError_Error(t *testing.T) {
+	t.Run("without parameter", func(t *testing.T) {
+		// --- Given ---
+		e := &ValidationError{Path: "A.B", Rule: "required"}
+
+		// --- When ---
+		have := e.Error()
+
+		// --- Then ---
+		assert.Equal(t, "A.B: rule required failed", have)
+	})
+
+	t.Run("with parameter", func(t *testing.T) {
+		// --- Given ---
+		e := &ValidationError{Path: "A", Rule: "min", Param: "1"}
+
+		// --- When ---
+		have := e.Error()
+
+		// --- Then ---
+		assert.Equal(t, "A: rule min=1 failed", have)
+	})
+}
+
+func Test_ValidationError_Is(t *testing.T) {
+	// --- Given ---
+	e := &ValidationError{Path: "A", Rule: "required"}
+
+	// --- Then ---
+	assert.True(t, errors.Is(e, ErrValidation))
+	assert.False(t, errors.Is(e, ErrValidationRule))
+}
+
+func Test_ValidationErrors(t *testing.T) {
+	// --- Given ---
+	es := ValidationErrors{
+		{Path: "A", Rule: "required"},
+		{Path: "B", Rule: "max", Param: "2"},
+	}
+
+	// --- When ---
+	var err error = es
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrValidation, err)
+	assert.ErrorEqual(t, "A: rule required failed\nB: rule max=2 failed", err)
+	assert.Len(t, 2, es.Unwrap())
+}
+
+func Test_NewValidator(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// --- When ---
+		have := NewValidator()
+
+		// --- Then ---
+		assert.Equal(t, "validate", have.tagKey)
+		assert.Equal(t, []string{"json"}, have.naming.Keys)
+		assert.NotNil(t, have.rules["min"])
+		assert.NotNil(t, have.cache)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- When ---
+		have := NewValidator(WithValidateTag("v"), WithPathTag("yaml"))
+
+		// --- Then ---
+		assert.Equal(t, "v", have.tagKey)
+		assert.Equal(t, []string{"yaml"}, have.naming.Keys)
+	})
+}
+
+func Test_Validator_Validate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		s := newTValidate()
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("valid struct value", func(t *testing.T) {
+		// --- Given ---
+		s := newTValidate()
+
+		// --- When ---
+		err := NewValidator().Validate(*s)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("all errors are returned", func(t *testing.T) {
+		// --- Given ---
+		s := newTValidate()
+		s.Name = ""
+		s.Age = 10
+		s.Role = "guest"
+		s.Tags = []string{"a", "", "a"}
+		s.Meta = map[string]int{"a": 1, "b": 6}
+		s.Address.City = ""
+		s.Address.Zip = "00001"
+		s.Confirm = "other"
+		s.Skipped = ""
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		var es ValidationErrors
+		assert.True(t, errors.As(err, &es))
+		want := ValidationErrors{
+			{Path: "Name", TagPath: "name", Rule: "required"},
+			{Path: "Age", TagPath: "age", Rule: "min", Param: "18"},
+			{Path: "Role", TagPath: "role", Rule: "oneof", Param: "admin user"},
+			{Path: "Tags", TagPath: "tags", Rule: "unique"},
+			{Path: "Tags[1]", TagPath: "tags[1]", Rule: "required"},
+			{Path: "Meta[b]", TagPath: "meta[b]", Rule: "max", Param: "5"},
+			{Path: "Address.City", TagPath: "address.city", Rule: "required"},
+			{
+				Path:    "Address.Zip",
+				TagPath: "address.zip",
+				Rule:    "regexp",
+				Param:   "^[0-9]{2,3}-[0-9]{3}$",
+			},
+			{
+				Path:    "Confirm",
+				TagPath: "confirm",
+				Rule:    "eqfield",
+				Param:   "Password",
+			},
+		}
+		assert.Equal(t, want, es)
+	})
+
+	t.Run("nil pointer is checked only by required", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F *string `validate:"min=3"`
+		}{}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("pointer value is validated", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F *string `validate:"required,min=3"`
+		}{F: ptr("ab")}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "F: rule min=3 failed", err)
+	})
+
+	t.Run("embedded struct", func(t *testing.T) {
+		// --- Given ---
+		type Base struct {
+			ID int `json:"id" validate:"min=1"`
+		}
+		s := &struct {
+			Base
+		}{}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		var es ValidationErrors
+		assert.True(t, errors.As(err, &es))
+		assert.Equal(t, "Base.ID", es[0].Path)
+		assert.Equal(t, "id", es[0].TagPath)
+	})
+
+	t.Run("unexported embedded struct", func(t *testing.T) {
+		// --- Given ---
+		type base struct {
+			ID int `json:"id" validate:"min=1"`
+		}
+		s := &struct {
+			base
+		}{}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		var es ValidationErrors
+		assert.True(t, errors.As(err, &es))
+		assert.Len(t, 1, es)
+		assert.Equal(t, "base.ID", es[0].Path)
+		assert.Equal(t, "id", es[0].TagPath)
+	})
+
+	t.Run("unexported embedded struct passed by value", func(t *testing.T) {
+		// --- Given ---
+		type base struct {
+			Tags []string `validate:"unique"`
+		}
+		s := struct {
+			base
+		}{base: base{Tags: []string{"a", "a"}}}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "base.Tags: rule unique failed", err)
+	})
+
+	t.Run("eqfield promoted from unexported embedded", func(t *testing.T) {
+		// --- Given ---
+		type base struct{ Password string }
+		s := struct {
+			base
+			Confirm string `validate:"eqfield=Password"`
+		}{base: base{Password: "a"}, Confirm: "b"}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "Confirm: rule eqfield=Password failed", err)
+	})
+
+	t.Run("unexported embedded struct pointer", func(t *testing.T) {
+		// --- Given ---
+		type base struct {
+			ID int `validate:"min=1"`
+		}
+		s := &struct {
+			*base
+		}{base: &base{}}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "base.ID: rule min=1 failed", err)
+	})
+
+	t.Run("recursive struct", func(t *testing.T) {
+		// --- Given ---
+		s := &TValidateNode{Name: "a"}
+		s.Next = &TValidateNode{Next: s}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "Next.Name: rule required failed", err)
+	})
+
+	t.Run("eqfield promoted through nil embedded pointer", func(t *testing.T) {
+		// --- Given ---
+		type Base struct{ Password string }
+		s := &struct {
+			*Base
+			Confirm string `validate:"eqfield=Password"`
+		}{Confirm: "a"}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "Confirm: rule eqfield=Password failed", err)
+	})
+
+	t.Run("unique interface elements not comparable", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			L []any `validate:"unique"`
+		}{L: []any{[]int{1}, []int{1}}}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "L: rule unique failed", err)
+	})
+
+	t.Run("unexported fields are skipped", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			f string `validate:"required"`
+		}{}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("custom path tag", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F string `yaml:"f" validate:"required"`
+		}{}
+
+		// --- When ---
+		err := NewValidator(WithPathTag("yaml")).Validate(s)
+
+		// --- Then ---
+		var es ValidationErrors
+		assert.True(t, errors.As(err, &es))
+		assert.Equal(t, "f", es[0].TagPath)
+	})
+
+	t.Run("naming policy", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F0 string `json:"f0" db:"col0" validate:"required"`
+			F1 string `json:"f1" validate:"required"`
+			F2 string `validate:"required"`
+		}{}
+		np := NamingPolicy{
+			Keys:     []string{"db", "json"},
+			Fallback: LowerCase,
+		}
+
+		// --- When ---
+		err := NewValidator(WithNamingPolicy(np)).Validate(s)
+
+		// --- Then ---
+		var es ValidationErrors
+		assert.True(t, errors.As(err, &es))
+		assert.Equal(t, "col0", es[0].TagPath)
+		assert.Equal(t, "f1", es[1].TagPath)
+		assert.Equal(t, "f2", es[2].TagPath)
+	})
+
+	t.Run("custom validation tag", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F string `v:"required"`
+		}{}
+
+		// --- When ---
+		err := NewValidator(WithValidateTag("v")).Validate(s)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "F: rule required failed", err)
+	})
+
+	t.Run("error - not a struct", func(t *testing.T) {
+		// --- When ---
+		err := NewValidator().Validate(42)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValidationRule, err)
+		wMsg := "invalid validation rule: expected struct got int"
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("error - nil pointer", func(t *testing.T) {
+		// --- Given ---
+		var s *TValidate
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValidationRule, err)
+	})
+
+	t.Run("error - unknown rule", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F string `validate:"abc"`
+		}{}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValidationRule, err)
+		assert.False(t, errors.Is(err, ErrValidation))
+		assert.Contain(t, `unknown rule "abc"`, err.Error())
+	})
+
+	t.Run("error - rule not applicable", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F bool `validate:"min=1"`
+		}{}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValidationRule, err)
+		assert.ErrorIs(t, errRuleType, err)
+	})
+
+	t.Run("error - dive on not a container", func(t *testing.T) {
+		// --- Given ---
+		s := &struct {
+			F int `validate:"dive,min=1"`
+		}{}
+
+		// --- When ---
+		err := NewValidator().Validate(s)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValidationRule, err)
+	})
+}
+
+func Test_Validator_RegisterRule(t *testing.T) {
+	t.Run("custom rule", func(t *testing.T) {
+		// --- Given ---
+		vd := NewValidator()
+		vd.RegisterRule("even", func(rc RuleContext) bool {
+			return rc.Value.Int()%2 == 0
+		})
+		s := &struct {
+			F int `validate:"even"`
+		}{F: 3}
+
+		// --- When ---
+		err := vd.Validate(s)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "F: rule even failed", err)
+	})
+
+	t.Run("rule context", func(t *testing.T) {
+		// --- Given ---
+		var have RuleContext
+		vd := NewValidator()
+		vd.RegisterRule("custom", func(rc RuleContext) bool {
+			have = rc
+			return true
+		})
+		s := &struct {
+			F int `validate:"custom=abc"`
+		}{F: 3}
+
+		// --- When ---
+		err := vd.Validate(s)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have.Param)
+		assert.Equal(t, int64(3), have.Value.Int())
+		assert.Equal(t, "F", have.Field.Name())
+		assert.Equal(t, reflect.TypeOf(s).Elem(), have.Parent.Type())
+	})
+
+	t.Run("resets cache", func(t *testing.T) {
+		// --- Given ---
+		vd := NewValidator()
+		assert.NoError(t, vd.Validate(&struct{ F int }{}))
+
+		// --- When ---
+		vd.RegisterRule("custom", func(rc RuleContext) bool { return true })
+
+		// --- Then ---
+		assert.Len(t, 0, vd.cache)
+	})
+}
+
+func Test_Validator_structRules(t *testing.T) {
+	t.Run("rules are cached", func(t *testing.T) {
+		// --- Given ---
+		vd := NewValidator()
+		typ := reflect.TypeOf(TValidate{})
+
+		// --- When ---
+		have, err := vd.structRules(typ)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, have, vd.cache[typ])
+		assert.Len(t, 8, have.fields)
+	})
+}
+
+func Test_Validate(t *testing.T) {
+	// --- Given ---
+	s := newTValidate()
+	s.Age = 100
+
+	// --- When ---
+	err := Validate(s)
+
+	// --- Then ---
+	assert.ErrorEqual(t, "Age: rule max=99 failed", err)
+}
+
+func Test_isEmpty_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val  any
+		want bool
+	}{
+		{"nil pointer", (*int)(nil), true},
+		{"pointer", ptr(0), false},
+		{"empty string", "", true},
+		{"string", "a", false},
+		{"nil slice", []int(nil), true},
+		{"empty slice", []int{}, true},
+		{"slice", []int{1}, false},
+		{"empty map", map[int]int{}, true},
+		{"zero int", 0, true},
+		{"int", 1, false},
+		{"zero struct", struct{ F int }{}, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := isEmpty(reflect.ValueOf(tc.val))
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}